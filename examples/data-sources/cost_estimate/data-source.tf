@@ -0,0 +1,21 @@
+data "searchstax_cost_estimate" "example" {
+  account_name = "my_account"
+
+  deployments = [
+    {
+      name                     = "search-prod"
+      application              = "Solr"
+      plan_type                = "DedicatedDeployment"
+      plan                     = "NDC4-GCP-G"
+      region_id                = "us-west-1"
+      cloud_provider_id        = "gcp"
+      num_additional_app_nodes = 2
+    },
+  ]
+
+  deployment_uids = ["ss123456"]
+}
+
+output "monthly_total" {
+  value = data.searchstax_cost_estimate.example.total_monthly_estimate
+}
@@ -2,3 +2,11 @@ data "searchstax_deployment_backups" "example" {
   account_name   = "my_account"
   deployment_uid = "ss123456"
 }
+
+# The latest successful backup of the deployment.
+data "searchstax_deployment_backups" "latest" {
+  account_name   = "my_account"
+  deployment_uid = "ss123456"
+  status         = "Success"
+  newest         = 1
+}
@@ -0,0 +1,13 @@
+ephemeral "searchstax_api_key" "example" {
+  account_name = "my_account"
+  scope        = ["deployment.dedicateddeployment"]
+}
+
+# Feed the key to another provider without storing it in the state. The key
+# is revoked at the end of the run.
+provider "restapi" {
+  uri = "https://app.searchstax.com/api/rest/v2"
+  headers = {
+    Authorization = "APIkey ${ephemeral.searchstax_api_key.example.api_key}"
+  }
+}
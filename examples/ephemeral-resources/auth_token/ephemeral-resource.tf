@@ -0,0 +1,9 @@
+ephemeral "searchstax_auth_token" "example" {}
+
+# Feed the token to another provider without storing it in the state.
+provider "restapi" {
+  uri = "https://app.searchstax.com/api/rest/v2"
+  headers = {
+    Authorization = "Token ${ephemeral.searchstax_auth_token.example.token}"
+  }
+}
@@ -9,6 +9,8 @@ terraform {
 provider "searchstax" {
   # Credentials can also be supplied via environment variables:
   # SEARCHSTAX_USERNAME, SEARCHSTAX_PASSWORD, SEARCHSTAX_HOST
+  # In CI, prefer an account API key (api_key or SEARCHSTAX_API_KEY) instead
+  # of username and password.
   username = var.ssx_username
   password = var.ssx_pwd
   host     = var.ssx_host
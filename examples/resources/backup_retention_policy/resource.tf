@@ -0,0 +1,6 @@
+resource "searchstax_backup_retention_policy" "example" {
+  account_name   = "my_account"
+  deployment_uid = "ss123456"
+  keep_last      = 7
+  max_age_days   = 30
+}
@@ -10,3 +10,20 @@ resource "searchstax_deployment" "example" {
   cloud_provider_id        = "gcp"
   num_additional_app_nodes = 0
 }
+
+# A staging copy of the deployment above, restored from a fresh backup of it.
+resource "searchstax_deployment" "staging" {
+  account_name        = "my_account"
+  name                = "SolrFromTerraformStaging"
+  application         = "Solr"
+  application_version = "8.11.2"
+  termination_lock    = false
+  plan_type           = "DedicatedDeployment"
+  plan                = "NDC4-GCP-G"
+  region_id           = "us-west-1"
+  cloud_provider_id   = "gcp"
+
+  source {
+    deployment_uid = searchstax_deployment.example.uid
+  }
+}
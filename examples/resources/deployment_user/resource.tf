@@ -10,3 +10,40 @@ variable "solr_user_password" {
   type      = string
   sensitive = true
 }
+
+# With Terraform 1.11 or later, keep the password out of the state entirely.
+# Bump password_wo_version to rotate it.
+resource "searchstax_deployment_user" "write_only" {
+  account_name        = "my_account"
+  deployment_uid      = "ss123456"
+  username            = "solrreader"
+  password_wo         = var.solr_reader_password
+  password_wo_version = 1
+  role                = "Read"
+}
+
+variable "solr_reader_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+# Let the provider generate the password and rotate it every 90 days, or
+# whenever a keeper changes.
+resource "searchstax_deployment_user" "generated" {
+  account_name      = "my_account"
+  deployment_uid    = "ss123456"
+  username          = "solrapp"
+  role              = "ReadWrite"
+  generate_password = true
+  rotation_days     = 90
+
+  keepers = {
+    rotation = "2024-q1"
+  }
+}
+
+output "solrapp_password" {
+  value     = searchstax_deployment_user.generated.generated_password
+  sensitive = true
+}
@@ -2,4 +2,8 @@ resource "searchstax_restore" "example" {
   account_name   = "my_account"
   deployment_uid = "ss123456"
   backup_id      = "27004"
+
+  timeouts {
+    create = "60m"
+  }
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -63,20 +64,12 @@ func (m *AlertMetric) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (c *Client) GetAlertMetrics(accountName string) (*AlertMetricsList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/alerts/metrics/", c.HostURL, accountName), nil)
+func (c *Client) GetAlertMetrics(ctx context.Context, accountName string) (*AlertMetricsList, error) {
+	metrics, err := listAll(ctx, c, fmt.Sprintf("%s/account/%s/alerts/metrics/", c.HostURL, accountName), decodeListPage[AlertMetric](""))
 	if err != nil {
 		return nil, err
 	}
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	out := AlertMetricsList{}
-	if err := decodeResults(body, &out.Results); err != nil {
-		return nil, err
-	}
-	return &out, nil
+	return &AlertMetricsList{Results: metrics}, nil
 }
 
 type IncidentsList struct {
@@ -87,20 +80,12 @@ type Incident struct {
 	ID int64 `json:"id,omitempty"`
 }
 
-func (c *Client) GetIncidents(accountName, deploymentID string) (*IncidentsList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/incidents/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetIncidents(ctx context.Context, accountName, deploymentID string) (*IncidentsList, error) {
+	incidents, err := listAll(ctx, c, fmt.Sprintf("%s/account/%s/deployment/%s/incidents/", c.HostURL, accountName, deploymentID), decodeListPage[Incident]("incidents"))
 	if err != nil {
 		return nil, err
 	}
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	out := IncidentsList{}
-	if err := decodeNamedList(body, "incidents", &out.Results); err != nil {
-		return nil, err
-	}
-	return &out, nil
+	return &IncidentsList{Results: incidents}, nil
 }
 
 type AlertsList struct {
@@ -112,28 +97,20 @@ type Alert struct {
 	Name string `json:"name,omitempty"`
 }
 
-func (c *Client) GetAlerts(accountName, deploymentID string) (*AlertsList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetAlerts(ctx context.Context, accountName, deploymentID string) (*AlertsList, error) {
+	alerts, err := listAll(ctx, c, fmt.Sprintf("%s/account/%s/deployment/%s/alerts/", c.HostURL, accountName, deploymentID), decodeListPage[Alert]("alerts"))
 	if err != nil {
 		return nil, err
 	}
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	out := AlertsList{}
-	if err := decodeNamedList(body, "alerts", &out.Results); err != nil {
-		return nil, err
-	}
-	return &out, nil
+	return &AlertsList{Results: alerts}, nil
 }
 
-func (c *Client) CreateAlert(accountName, deploymentID string, alert Alert) (int64, error) {
+func (c *Client) CreateAlert(ctx context.Context, accountName, deploymentID string, alert Alert) (int64, error) {
 	rb, err := json.Marshal(alert)
 	if err != nil {
 		return 0, err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
 	if err != nil {
 		return 0, err
 	}
@@ -142,7 +119,7 @@ func (c *Client) CreateAlert(accountName, deploymentID string, alert Alert) (int
 	if _, err := c.doRequest(req); err != nil {
 		return 0, err
 	}
-	list, err := c.GetAlerts(accountName, deploymentID)
+	list, err := c.GetAlerts(ctx, accountName, deploymentID)
 	if err != nil {
 		return 0, err
 	}
@@ -157,12 +134,12 @@ func (c *Client) CreateAlert(accountName, deploymentID string, alert Alert) (int
 	return 0, nil
 }
 
-func (c *Client) UpdateAlert(accountName, deploymentID string, id int64, alert Alert) error {
+func (c *Client) UpdateAlert(ctx context.Context, accountName, deploymentID string, id int64, alert Alert) error {
 	rb, err := json.Marshal(alert)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/%d/", c.HostURL, accountName, deploymentID, id), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/%d/", c.HostURL, accountName, deploymentID, id), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -174,8 +151,8 @@ func (c *Client) UpdateAlert(accountName, deploymentID string, id int64, alert A
 	return nil
 }
 
-func (c *Client) DeleteAlert(accountName, deploymentID string, id int64) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/%d/", c.HostURL, accountName, deploymentID, id), nil)
+func (c *Client) DeleteAlert(ctx context.Context, accountName, deploymentID string, id int64) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/%d/", c.HostURL, accountName, deploymentID, id), nil)
 	if err != nil {
 		return err
 	}
@@ -220,28 +197,20 @@ func (h *Heartbeat) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (c *Client) GetHeartbeats(accountName, deploymentID string) (*HeartbeatsList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/heartbeat/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetHeartbeats(ctx context.Context, accountName, deploymentID string) (*HeartbeatsList, error) {
+	heartbeats, err := listAll(ctx, c, fmt.Sprintf("%s/account/%s/deployment/%s/alerts/heartbeat/", c.HostURL, accountName, deploymentID), decodeListPage[Heartbeat]("alerts"))
 	if err != nil {
 		return nil, err
 	}
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	out := HeartbeatsList{}
-	if err := decodeNamedList(body, "alerts", &out.Results); err != nil {
-		return nil, err
-	}
-	return &out, nil
+	return &HeartbeatsList{Results: heartbeats}, nil
 }
 
-func (c *Client) CreateHeartbeat(accountName, deploymentID string, hb Heartbeat) (int64, error) {
+func (c *Client) CreateHeartbeat(ctx context.Context, accountName, deploymentID string, hb Heartbeat) (int64, error) {
 	rb, err := json.Marshal(hb)
 	if err != nil {
 		return 0, err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/heartbeat/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/heartbeat/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
 	if err != nil {
 		return 0, err
 	}
@@ -250,7 +219,7 @@ func (c *Client) CreateHeartbeat(accountName, deploymentID string, hb Heartbeat)
 	if _, err := c.doRequest(req); err != nil {
 		return 0, err
 	}
-	list, err := c.GetHeartbeats(accountName, deploymentID)
+	list, err := c.GetHeartbeats(ctx, accountName, deploymentID)
 	if err != nil {
 		return 0, err
 	}
@@ -265,8 +234,8 @@ func (c *Client) CreateHeartbeat(accountName, deploymentID string, hb Heartbeat)
 	return 0, nil
 }
 
-func (c *Client) GetHeartbeat(accountName, deploymentID string, id int64) (*Heartbeat, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/heartbeat/%d/", c.HostURL, accountName, deploymentID, id), nil)
+func (c *Client) GetHeartbeat(ctx context.Context, accountName, deploymentID string, id int64) (*Heartbeat, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/heartbeat/%d/", c.HostURL, accountName, deploymentID, id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -281,12 +250,12 @@ func (c *Client) GetHeartbeat(accountName, deploymentID string, id int64) (*Hear
 	return &out, nil
 }
 
-func (c *Client) UpdateHeartbeat(accountName, deploymentID string, id int64, hb Heartbeat) error {
+func (c *Client) UpdateHeartbeat(ctx context.Context, accountName, deploymentID string, id int64, hb Heartbeat) error {
 	rb, err := json.Marshal(hb)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/heartbeat/%d/", c.HostURL, accountName, deploymentID, id), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/heartbeat/%d/", c.HostURL, accountName, deploymentID, id), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -298,8 +267,8 @@ func (c *Client) UpdateHeartbeat(accountName, deploymentID string, id int64, hb
 	return nil
 }
 
-func (c *Client) DeleteHeartbeat(accountName, deploymentID string, id int64) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/heartbeat/%d/", c.HostURL, accountName, deploymentID, id), nil)
+func (c *Client) DeleteHeartbeat(ctx context.Context, accountName, deploymentID string, id int64) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/account/%s/deployment/%s/alerts/heartbeat/%d/", c.HostURL, accountName, deploymentID, id), nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// APIError is returned by doRequest when the API responds with a non-2xx
+// status. It preserves the status code so callers can distinguish, for example,
+// a 404 (resource gone) from a transient 5xx or auth error, and the error
+// message and per-field errors parsed from the common SearchStax payloads:
+//
+//	{"detail": "Not found."}
+//	{"success": "false", "message": "Invalid plan"}
+//	{"region_id": ["Invalid region."], "non_field_errors": ["..."]}
+type APIError struct {
+	StatusCode int
+	Body       string
+
+	// Code is the error code sent by the API ("code" or "error_code"), if any.
+	Code string
+	// Message is the general error message, empty when the body had none.
+	Message string
+	// FieldErrors maps request field names (e.g. "plan") to their errors.
+	FieldErrors map[string][]string
+
+	// retryAfter is the wait requested by a 429 or 503 Retry-After header.
+	retryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" && len(e.FieldErrors) == 0 {
+		return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+	}
+	parts := make([]string, 0, 1+len(e.FieldErrors))
+	if e.Message != "" {
+		parts = append(parts, e.Message)
+	}
+	for _, field := range e.Fields() {
+		parts = append(parts, fmt.Sprintf("%s: %s", field, strings.Join(e.FieldErrors[field], " ")))
+	}
+	return fmt.Sprintf("status: %d, %s", e.StatusCode, strings.Join(parts, "; "))
+}
+
+// Fields returns the names of the fields with errors, sorted.
+func (e *APIError) Fields() []string {
+	fields := make([]string, 0, len(e.FieldErrors))
+	for field := range e.FieldErrors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// apiMessageKeys hold a general message rather than a field error, in order
+// of preference.
+var apiMessageKeys = []string{"detail", "message", "error", "non_field_errors", "errors"}
+
+// newAPIError builds the APIError for a non-2xx response, parsing body when it
+// is a JSON object. Unparseable bodies are kept verbatim in Body.
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{StatusCode: statusCode, Body: string(body)}
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return apiErr
+	}
+
+	for _, key := range []string{"code", "error_code"} {
+		if code := messageText(payload[key]); code != "" {
+			apiErr.Code = code
+			break
+		}
+	}
+
+	for _, key := range apiMessageKeys {
+		raw, ok := payload[key]
+		if !ok {
+			continue
+		}
+		if nested := fieldErrors(raw); key == "errors" && len(nested) > 0 {
+			// {"errors": {"plan": ["..."]}} wraps the field errors.
+			apiErr.addFieldErrors(nested)
+			continue
+		}
+		if msg := messageText(raw); msg != "" && apiErr.Message == "" {
+			apiErr.Message = msg
+		}
+	}
+
+	for key, raw := range payload {
+		if isReservedAPIKey(key) {
+			continue
+		}
+		// Only list-valued keys are field errors (Django REST framework
+		// style); scalar keys such as "status" are ordinary response data.
+		var msgs []string
+		if err := json.Unmarshal(raw, &msgs); err == nil && len(msgs) > 0 {
+			apiErr.addFieldErrors(map[string][]string{key: msgs})
+		}
+	}
+
+	// {"success": false} without a message still deserves an explanation.
+	if apiErr.Message == "" && len(apiErr.FieldErrors) == 0 && strings.EqualFold(messageText(payload["success"]), "false") {
+		apiErr.Message = "the API reported success: false"
+	}
+	return apiErr
+}
+
+func (e *APIError) addFieldErrors(errs map[string][]string) {
+	if e.FieldErrors == nil {
+		e.FieldErrors = make(map[string][]string)
+	}
+	for field, msgs := range errs {
+		e.FieldErrors[field] = append(e.FieldErrors[field], msgs...)
+	}
+}
+
+func isReservedAPIKey(key string) bool {
+	switch key {
+	case "success", "code", "error_code":
+		return true
+	}
+	for _, k := range apiMessageKeys {
+		if key == k {
+			return true
+		}
+	}
+	return false
+}
+
+// messageText renders a JSON string, bool, number or list of strings as text.
+func messageText(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err == nil {
+		return strings.Join(list, " ")
+	}
+	var scalar interface{}
+	if err := json.Unmarshal(raw, &scalar); err == nil {
+		switch v := scalar.(type) {
+		case bool, float64:
+			return fmt.Sprint(v)
+		}
+	}
+	return ""
+}
+
+// fieldErrors decodes an object of field name to message(s).
+func fieldErrors(raw json.RawMessage) map[string][]string {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil
+	}
+	out := make(map[string][]string, len(obj))
+	for field, value := range obj {
+		if msg := messageText(value); msg != "" {
+			out[field] = []string{msg}
+		}
+	}
+	return out
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	t.Run("detail", func(t *testing.T) {
+		e := newAPIError(http.StatusNotFound, []byte(`{"detail":"Not found."}`))
+		if e.Message != "Not found." || e.Error() != "status: 404, Not found." {
+			t.Fatalf("unexpected error: %#v", e)
+		}
+	})
+
+	t.Run("success false with message", func(t *testing.T) {
+		e := newAPIError(http.StatusBadRequest, []byte(`{"success":"false","message":"Invalid plan"}`))
+		if e.Message != "Invalid plan" || len(e.FieldErrors) != 0 {
+			t.Fatalf("unexpected error: %#v", e)
+		}
+	})
+
+	t.Run("field errors", func(t *testing.T) {
+		e := newAPIError(http.StatusBadRequest, []byte(`{"region_id":["Invalid region."],"plan":["Unknown plan.","Try another."],"non_field_errors":["Bad request."],"status":"error"}`))
+		if e.Message != "Bad request." {
+			t.Fatalf("unexpected message: %q", e.Message)
+		}
+		if len(e.FieldErrors) != 2 || e.FieldErrors["plan"][1] != "Try another." {
+			t.Fatalf("unexpected field errors: %#v", e.FieldErrors)
+		}
+		if got := e.Error(); got != "status: 400, Bad request.; plan: Unknown plan. Try another.; region_id: Invalid region." {
+			t.Fatalf("unexpected Error(): %q", got)
+		}
+	})
+
+	t.Run("nested errors and code", func(t *testing.T) {
+		e := newAPIError(http.StatusBadRequest, []byte(`{"success":false,"code":"invalid","errors":{"plan_type":"Unknown plan type."}}`))
+		if e.Code != "invalid" || e.FieldErrors["plan_type"][0] != "Unknown plan type." {
+			t.Fatalf("unexpected error: %#v", e)
+		}
+	})
+
+	t.Run("not json", func(t *testing.T) {
+		e := newAPIError(http.StatusBadGateway, []byte(`<html>bad gateway</html>`))
+		if e.Error() != "status: 502, body: <html>bad gateway</html>" {
+			t.Fatalf("unexpected Error(): %q", e.Error())
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -15,12 +16,12 @@ type CreateAPIKeyResponse struct {
 	APIKey string `json:"apikey"`
 }
 
-func (c *Client) CreateAPIKey(accountName string, reqBody CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+func (c *Client) CreateAPIKey(ctx context.Context, accountName string, reqBody CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/apikey/", c.HostURL, accountName), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/apikey/", c.HostURL, accountName), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -47,12 +48,12 @@ type APIKeyDeploymentsResponse struct {
 	Deployments []string `json:"deployments"`
 }
 
-func (c *Client) AssociateAPIKey(accountName string, reqBody AssociateAPIKeyRequest) (*APIKeyDeploymentsResponse, error) {
+func (c *Client) AssociateAPIKey(ctx context.Context, accountName string, reqBody AssociateAPIKeyRequest) (*APIKeyDeploymentsResponse, error) {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/apikey/associate/", c.HostURL, accountName), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/apikey/associate/", c.HostURL, accountName), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -67,12 +68,12 @@ func (c *Client) AssociateAPIKey(accountName string, reqBody AssociateAPIKeyRequ
 	return &out, nil
 }
 
-func (c *Client) DisassociateAPIKey(accountName string, reqBody AssociateAPIKeyRequest) (*APIKeyDeploymentsResponse, error) {
+func (c *Client) DisassociateAPIKey(ctx context.Context, accountName string, reqBody AssociateAPIKeyRequest) (*APIKeyDeploymentsResponse, error) {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/apikey/disassociate/", c.HostURL, accountName), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/apikey/disassociate/", c.HostURL, accountName), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -91,12 +92,12 @@ type APIKeyDeploymentsRequest struct {
 	APIKey string `json:"apikey"`
 }
 
-func (c *Client) GetAPIKeyDeployments(accountName string, reqBody APIKeyDeploymentsRequest) (*APIKeyDeploymentsResponse, error) {
+func (c *Client) GetAPIKeyDeployments(ctx context.Context, accountName string, reqBody APIKeyDeploymentsRequest) (*APIKeyDeploymentsResponse, error) {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/apikey/deployments/", c.HostURL, accountName), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/apikey/deployments/", c.HostURL, accountName), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -131,12 +132,12 @@ type DeploymentAPIKeysResponse struct {
 	APIKey []string `json:"apikey"`
 }
 
-func (c *Client) GetDeploymentAPIKeys(accountName string, reqBody DeploymentAPIKeysRequest) (*DeploymentAPIKeysResponse, error) {
+func (c *Client) GetDeploymentAPIKeys(ctx context.Context, accountName string, reqBody DeploymentAPIKeysRequest) (*DeploymentAPIKeysResponse, error) {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/apikey/list/", c.HostURL, accountName), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/apikey/list/", c.HostURL, accountName), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -167,12 +168,12 @@ type RevokeAPIKeyRequest struct {
 	APIKey string `json:"apikey"`
 }
 
-func (c *Client) RevokeAPIKey(accountName string, reqBody RevokeAPIKeyRequest) error {
+func (c *Client) RevokeAPIKey(ctx context.Context, accountName string, reqBody RevokeAPIKeyRequest) error {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/apikey/revoke/", c.HostURL, accountName), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/apikey/revoke/", c.HostURL, accountName), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
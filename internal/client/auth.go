@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,7 +10,7 @@ import (
 )
 
 // SignIn - Get a new token for user.
-func (c *Client) SignIn() (*AuthResponse, error) {
+func (c *Client) SignIn(ctx context.Context) (*AuthResponse, error) {
 	if c.Auth.Username == "" || c.Auth.Password == "" {
 		return nil, fmt.Errorf("define username and password")
 	}
@@ -18,7 +19,7 @@ func (c *Client) SignIn() (*AuthResponse, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/obtain-auth-token/", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/obtain-auth-token/", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -37,8 +38,58 @@ func (c *Client) SignIn() (*AuthResponse, error) {
 	return &ar, nil
 }
 
+// currentToken returns the token sent with API requests.
+func (c *Client) currentToken() string {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	return c.Token
+}
+
+func (c *Client) setToken(token string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+	c.Token = token
+}
+
+// canRefreshToken reports whether err is a 401 that signing in again could
+// fix: the client holds credentials and req is not itself a sign-in.
+func (c *Client) canRefreshToken(req *http.Request, err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	if c.Auth.Username == "" || c.Auth.Password == "" {
+		return false
+	}
+	return !isSignInRequest(req)
+}
+
+// isSignInRequest reports whether req obtains a new token. Such requests are
+// sent without the current token, which may be the expired one being replaced.
+func isSignInRequest(req *http.Request) bool {
+	return strings.Contains(req.URL.Path, "/obtain-auth-token")
+}
+
+// refreshToken signs in again after staleToken was rejected. refreshMu is held
+// for the whole sign-in so concurrent callers that hit the same 401 wait for
+// one new token instead of each requesting their own; a caller that finds the
+// token already replaced simply reuses it.
+func (c *Client) refreshToken(ctx context.Context, staleToken string) error {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+	if c.currentToken() != staleToken {
+		return nil
+	}
+	ar, err := c.SignIn(ctx)
+	if err != nil {
+		return err
+	}
+	c.setToken(ar.Token)
+	return nil
+}
+
 // GetUserTokenSignIn SignIn - Get a new token for user.
-func (c *Client) GetUserTokenSignIn(auth AuthStruct) (*AuthResponse, error) {
+func (c *Client) GetUserTokenSignIn(ctx context.Context, auth AuthStruct) (*AuthResponse, error) {
 	if auth.Username == "" || auth.Password == "" {
 		return nil, fmt.Errorf("define username and password")
 	}
@@ -47,7 +98,7 @@ func (c *Client) GetUserTokenSignIn(auth AuthStruct) (*AuthResponse, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/obtain-auth-token", c.HostURL), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/obtain-auth-token", c.HostURL), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -77,8 +128,8 @@ type VerifyAuthTokenResponse struct {
 	} `json:"token"`
 }
 
-func (c *Client) VerifyAuthToken() (*VerifyAuthTokenResponse, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/verify-auth-token/", c.HostURL), nil)
+func (c *Client) VerifyAuthToken(ctx context.Context) (*VerifyAuthTokenResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/verify-auth-token/", c.HostURL), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -96,14 +147,28 @@ func (c *Client) VerifyAuthToken() (*VerifyAuthTokenResponse, error) {
 	return &out, nil
 }
 
-// SignOut - Revoke the token for a user.
-func (c *Client) SignOut(authToken *string) error {
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/signout", c.HostURL), strings.NewReader(string("")))
+// SignOut - Revoke the token for a user. A non-empty authToken is signed out
+// instead of the client's own token.
+func (c *Client) SignOut(ctx context.Context, authToken *string) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/signout", c.HostURL), strings.NewReader(string("")))
 	if err != nil {
 		return err
 	}
 
-	body, err := c.doRequest(req)
+	// Send the request as the token's user, without the client's credentials,
+	// so a rejected token is not replaced by signing in again.
+	signer := c
+	if authToken != nil && *authToken != "" {
+		signer = &Client{
+			HostURL:      c.HostURL,
+			HTTPClient:   c.HTTPClient,
+			Token:        *authToken,
+			MaxRetries:   c.MaxRetries,
+			RetryMaxWait: c.RetryMaxWait,
+			limiter:      c.limiter,
+		}
+	}
+	body, err := signer.doRequest(req)
 	if err != nil {
 		return err
 	}
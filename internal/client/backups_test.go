@@ -0,0 +1,223 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestWaitForDeploymentBackup(t *testing.T) {
+	defer func(interval time.Duration) { backupPollInterval = interval }(backupPollInterval)
+	backupPollInterval = time.Millisecond
+
+	t.Run("waits until the backup succeeds", func(t *testing.T) {
+		polls := 0
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			polls++
+			switch polls {
+			case 1:
+				_, _ = w.Write([]byte(`[]`))
+			case 2:
+				_, _ = w.Write([]byte(`[{"id": 7, "status": "In Progress"}]`))
+			default:
+				_, _ = w.Write([]byte(`[{"id": 7, "status": "Success"}]`))
+			}
+		})
+
+		if err := c.WaitForDeploymentBackup(context.Background(), "acct", "ss1", "7"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if polls != 3 {
+			t.Errorf("polled %d times, want 3", polls)
+		}
+	})
+
+	t.Run("failed backup is an error", func(t *testing.T) {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[{"id": 7, "status": "Failed"}]`))
+		})
+
+		if err := c.WaitForDeploymentBackup(context.Background(), "acct", "ss1", "7"); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("backup without a status is complete only against the mock", func(t *testing.T) {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`[{"id": 7}]`))
+		})
+		if err := c.WaitForDeploymentBackup(context.Background(), "acct", "ss1", "7"); err != nil {
+			t.Fatalf("unexpected error against the mock: %v", err)
+		}
+
+		// Address the same server under a non-mock host name.
+		server, _ := url.Parse(c.HostURL)
+		c.HostURL = "https://searchstax.example"
+		c.HTTPClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			req.URL.Scheme, req.URL.Host = server.Scheme, server.Host
+			return http.DefaultTransport.RoundTrip(req)
+		})}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		err := c.WaitForDeploymentBackup(ctx, "acct", "ss1", "7")
+		var timeoutErr *TimeoutError
+		if !errors.As(err, &timeoutErr) {
+			t.Fatalf("expected a TimeoutError, got %v", err)
+		}
+	})
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWaitForDeploymentRestore(t *testing.T) {
+	defer func(interval, grace time.Duration) {
+		restorePollInterval, restoreStartGrace = interval, grace
+	}(restorePollInterval, restoreStartGrace)
+	restorePollInterval = time.Millisecond
+	restoreStartGrace = time.Hour
+
+	t.Run("waits until the restore is no longer running", func(t *testing.T) {
+		polls := 0
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			polls++
+			switch polls {
+			case 1:
+				_, _ = w.Write([]byte(`{"message": "Restore has been placed in the task queue"}`))
+			case 2:
+				_, _ = w.Write([]byte(`{"message": "Backup Restore in Progress"}`))
+			default:
+				_, _ = w.Write([]byte(`{"message": "No restore in progress"}`))
+			}
+		})
+
+		if _, err := c.WaitForDeploymentRestore(context.Background(), "acct", "ss1", "7"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if polls != 3 {
+			t.Errorf("polled %d times, want 3", polls)
+		}
+	})
+
+	t.Run("no restore within the grace period is not finished", func(t *testing.T) {
+		polls := 0
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			polls++
+			_, _ = w.Write([]byte(`{"message": "No restore in progress"}`))
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := c.WaitForDeploymentRestore(ctx, "acct", "ss1", "7")
+		var timeoutErr *TimeoutError
+		if !errors.As(err, &timeoutErr) {
+			t.Fatalf("expected a TimeoutError, got %v", err)
+		}
+		if timeoutErr.LastStatus != RestoreStatusNone {
+			t.Errorf("LastStatus = %q, want %q", timeoutErr.LastStatus, RestoreStatusNone)
+		}
+		if polls < 2 {
+			t.Errorf("polled %d times, want the wait to keep polling", polls)
+		}
+	})
+
+	t.Run("restore finished before the first poll ends after the grace period", func(t *testing.T) {
+		defer func(grace time.Duration) { restoreStartGrace = grace }(restoreStartGrace)
+		restoreStartGrace = 20 * time.Millisecond
+		polls := 0
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			polls++
+			_, _ = w.Write([]byte(`{"message": "No restore in progress"}`))
+		})
+
+		out, err := c.WaitForDeploymentRestore(context.Background(), "acct", "ss1", "7")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if RestoreStatus(out.Message) != RestoreStatusNone {
+			t.Errorf("last message %q, want a no-restore status", out.Message)
+		}
+		if polls < 2 {
+			t.Errorf("polled %d times, want the wait to keep polling during the grace period", polls)
+		}
+	})
+
+	t.Run("failed restore is an error", func(t *testing.T) {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"message": "Restore failed"}`))
+		})
+
+		if _, err := c.WaitForDeploymentRestore(context.Background(), "acct", "ss1", "7"); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
+
+func TestBackupUnmarshalJSON(t *testing.T) {
+	var backups []Backup
+	body := `[
+		{"id": 7, "date": "2024-03-01T10:00:00Z", "size": 2048, "status": "Success", "region_id": "us-east-1", "deployment": "ss1", "collections": ["products", "orders"]},
+		{"id": "8", "created": "2024-03-02 11:30:00", "size": "1.2 MB", "deployment_uid": "ss2", "collections": "products"}
+	]`
+	if err := decodeResults([]byte(body), &backups); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("got %d backups, want 2", len(backups))
+	}
+
+	first := backups[0]
+	if first.ID != "7" || first.Size != "2048" || first.Status != "Success" || first.RegionID != "us-east-1" || first.Deployment != "ss1" {
+		t.Errorf("unexpected first backup: %+v", first)
+	}
+	if len(first.Collections) != 2 {
+		t.Errorf("got collections %v, want 2", first.Collections)
+	}
+	if created, ok := first.CreatedAt(); !ok || !created.Equal(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("CreatedAt() = %v, %v", created, ok)
+	}
+
+	second := backups[1]
+	if second.ID != "8" || second.Size != "1.2 MB" || second.Deployment != "ss2" || second.Date != "2024-03-02 11:30:00" {
+		t.Errorf("unexpected second backup: %+v", second)
+	}
+	if created, ok := second.CreatedAt(); !ok || !created.Equal(time.Date(2024, 3, 2, 11, 30, 0, 0, time.UTC)) {
+		t.Errorf("CreatedAt() = %v, %v", created, ok)
+	}
+
+	if _, ok := (Backup{Date: "last tuesday"}).CreatedAt(); ok {
+		t.Error("CreatedAt() parsed an unknown date format")
+	}
+}
+
+func TestRestoreStatus(t *testing.T) {
+	tests := []struct {
+		message string
+		want    string
+	}{
+		{"", ""},
+		{"restore begun", RestoreStatusQueued},
+		{"Restore has been placed in the task queue", RestoreStatusQueued},
+		{"Backup Restore in Progress", RestoreStatusInProgress},
+		{"No restore in progress", RestoreStatusNone},
+		{"Restore completed successfully", RestoreStatusCompleted},
+		{"Restore failed", RestoreStatusFailed},
+		{"Error while restoring backup", RestoreStatusFailed},
+		{"Restore completed with no errors", RestoreStatusCompleted},
+		{"Restore finished successfully without error", RestoreStatusCompleted},
+		{"Restore failed to complete", RestoreStatusFailed},
+		{"Restore was unsuccessful", RestoreStatusFailed},
+		{"Something else", RestoreStatusUnknown},
+	}
+	for _, tt := range tests {
+		if got := RestoreStatus(tt.message); got != tt.want {
+			t.Errorf("RestoreStatus(%q) = %q, want %q", tt.message, got, tt.want)
+		}
+	}
+}
@@ -1,47 +1,43 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
-	"time"
 )
 
-func (c *Client) EnableBasicAuth(accountName, deploymentID string) (bool, error) {
-	const (
-		attempts = 10
-		backoff  = 15 * time.Second
-	)
+func (c *Client) EnableBasicAuth(ctx context.Context, accountName, deploymentID string) (bool, error) {
+	ctx, unlock, err := c.lockDeployment(ctx, accountName, deploymentID)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
 
-	var lastErr error
-	for i := 0; i < attempts; i++ {
-		req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/enable/", c.HostURL, accountName, deploymentID), nil)
-		if err != nil {
-			return false, err
-		}
-		if _, err := c.doRequest(req); err != nil {
-			// Retry on transient (5xx / network) errors — the cluster may be
-			// briefly unavailable while a restart is in progress.
-			if isTransient(err) {
-				lastErr = err
-				if i < attempts-1 {
-					time.Sleep(backoff)
-				}
-				continue
-			}
-			return false, err
-		}
-		// A successful (2xx) response means basic auth is enabled. The real API
-		// returns {"message": ..., "success": "true"}.
-		return true, nil
+	// doRequest retries transient (5xx / network) errors on this GET, which
+	// rides out the brief unavailability while a restart is in progress.
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/enable/", c.HostURL, accountName, deploymentID), nil)
+	if err != nil {
+		return false, err
+	}
+	if _, err := c.doRequest(req); err != nil {
+		return false, err
 	}
-	return false, fmt.Errorf("basic auth not enabled after %d attempts: %w", attempts, lastErr)
+	// A successful (2xx) response means basic auth is enabled. The real API
+	// returns {"message": ..., "success": "true"}.
+	return true, nil
 }
 
-func (c *Client) DisableBasicAuth(accountName, deploymentID string) (bool, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/disable/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) DisableBasicAuth(ctx context.Context, accountName, deploymentID string) (bool, error) {
+	ctx, unlock, err := c.lockDeployment(ctx, accountName, deploymentID)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/disable/", c.HostURL, accountName, deploymentID), nil)
 	if err != nil {
 		return false, err
 	}
@@ -49,9 +45,9 @@ func (c *Client) DisableBasicAuth(accountName, deploymentID string) (bool, error
 		// The real API returns 400 "No basic authentication enabled for this
 		// deployment." when auth is already disabled. Treat that as success so
 		// destroy is idempotent.
-		var httpErr *HTTPStatusError
-		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusBadRequest &&
-			strings.Contains(httpErr.Body, "No basic authentication enabled") {
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest &&
+			strings.Contains(apiErr.Body, "No basic authentication enabled") {
 			return false, nil
 		}
 		return false, err
@@ -66,12 +62,18 @@ type SetBasicAuthPasswordRequest struct {
 	Password string `json:"password"`
 }
 
-func (c *Client) SetBasicAuthPassword(accountName, deploymentID string, reqBody SetBasicAuthPasswordRequest) error {
+func (c *Client) SetBasicAuthPassword(ctx context.Context, accountName, deploymentID string, reqBody SetBasicAuthPasswordRequest) error {
+	ctx, unlock, err := c.lockDeployment(ctx, accountName, deploymentID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/set-password/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/set-password/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -89,17 +91,23 @@ type SetBasicAuthRoleRequest struct {
 }
 
 // IsBasicAuthEnabled reports whether Solr basic auth appears enabled for a deployment.
-func (c *Client) IsBasicAuthEnabled(accountName, deploymentID string) (bool, error) {
-	_, err := c.GetDeploymentUsers(accountName, deploymentID)
+func (c *Client) IsBasicAuthEnabled(ctx context.Context, accountName, deploymentID string) (bool, error) {
+	_, err := c.GetDeploymentUsers(ctx, accountName, deploymentID)
 	return err == nil, nil
 }
 
-func (c *Client) SetBasicAuthRole(accountName, deploymentID string, reqBody SetBasicAuthRoleRequest) error {
+func (c *Client) SetBasicAuthRole(ctx context.Context, accountName, deploymentID string, reqBody SetBasicAuthRoleRequest) error {
+	ctx, unlock, err := c.lockDeployment(ctx, accountName, deploymentID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/set-role/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/set-role/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
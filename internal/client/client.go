@@ -1,12 +1,17 @@
 package client
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
 )
 
 // HostURL - Default Searchstax URL.
@@ -17,7 +22,32 @@ type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
-	Auth       AuthStruct
+	// APIKey, when set, authenticates every request instead of Token.
+	APIKey string
+	Auth   AuthStruct
+	// MaxRetries is how many times doRequest repeats a request that failed
+	// with a retryable error; RetryMaxWait caps the wait between attempts.
+	MaxRetries   int
+	RetryMaxWait time.Duration
+	// DeletionProtection is the provider's deletion_protection setting. It
+	// travels with the client every resource receives; searchstax_deployment
+	// enforces it when planning a destroy. See WithDeletionProtection.
+	DeletionProtection bool
+
+	// tokenMu guards Token once the client is shared between resources;
+	// refreshMu makes sure only one of them signs in again when it expires.
+	tokenMu   sync.Mutex
+	refreshMu sync.Mutex
+
+	// limiter throttles outgoing requests; see WithRateLimit.
+	limiter *rate.Limiter
+
+	// deploymentLocks is the single per-deployment lock. lockDeployment holds
+	// it for a whole mutating operation and, with serializeDeployments, throttle
+	// holds it for each mutating request sent outside such an operation; see
+	// WithDeploymentSerialization.
+	serializeDeployments bool
+	deploymentLocks      keyedMutex
 }
 
 // AuthStruct - AuthStruct struct.
@@ -31,22 +61,56 @@ type AuthResponse struct {
 	Token string `json:"token"`
 }
 
+// Option configures optional Client behavior in NewClient.
+type Option func(*Client)
+
+// WithAPIKey authenticates requests with a SearchStax API key. Combine it with
+// nil username and password so NewClient does not sign in.
+func WithAPIKey(apiKey string) Option {
+	return func(c *Client) {
+		c.APIKey = apiKey
+	}
+}
+
+// WithToken authenticates requests with an existing auth token. Combine it
+// with nil username and password so NewClient does not sign in; such a token
+// cannot be refreshed when it expires.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.Token = token
+	}
+}
+
+// WithDeletionProtection records the provider's deletion_protection setting
+// on the client, where the resources read it.
+func WithDeletionProtection(enabled bool) Option {
+	return func(c *Client) {
+		c.DeletionProtection = enabled
+	}
+}
+
 // NewClient - initialize a new Client.
-func NewClient(host, username, password *string) (*Client, error) {
+func NewClient(ctx context.Context, host, username, password *string, opts ...Option) (*Client, error) {
 	c := Client{
 		// Solr operations such as enabling basic auth or a rolling restart
 		// can take several minutes to return (the API responds synchronously),
 		// so use a generous timeout instead of the default 30s.
 		HTTPClient: &http.Client{Timeout: 10 * time.Minute},
 		// Default Searchstax URL
-		HostURL: HostURL,
+		HostURL:      HostURL,
+		MaxRetries:   DefaultMaxRetries,
+		RetryMaxWait: DefaultRetryMaxWait,
+	}
+	for _, opt := range opts {
+		opt(&c)
 	}
 
 	if host != nil && *host != "" {
 		c.HostURL = *host
 	}
 
-	// If username or password not provided, return empty client
+	// If username or password not provided, return a client that uses the
+	// API key or token set through opts, if any
 	if username == nil || password == nil {
 		return &c, nil
 	}
@@ -56,12 +120,12 @@ func NewClient(host, username, password *string) (*Client, error) {
 		Password: *password,
 	}
 
-	ar, err := c.SignIn()
+	ar, err := c.SignIn(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	c.Token = ar.Token
+	c.setToken(ar.Token)
 
 	return &c, nil
 }
@@ -79,16 +143,62 @@ func (c *Client) isMockHost() bool {
 	return strings.Contains(c.HostURL, "localhost") || strings.Contains(c.HostURL, "127.0.0.1")
 }
 
-// HTTPStatusError is returned by doRequest when the API responds with a non-2xx
-// status. It preserves the status code so callers can distinguish, for example,
-// a 404 (resource gone) from a transient 5xx or auth error.
-type HTTPStatusError struct {
-	StatusCode int
-	Body       string
+// TimeoutError is returned by the client's wait loops when the caller's
+// context expires (or is canceled) before a long-running operation finishes.
+// It names the deployment and the last status observed so the diagnostic shows
+// where the operation was stuck.
+type TimeoutError struct {
+	Operation    string
+	DeploymentID string
+	LastStatus   string
+	Elapsed      time.Duration
+	Err          error
 }
 
-func (e *HTTPStatusError) Error() string {
-	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+func (e *TimeoutError) Error() string {
+	status := e.LastStatus
+	if status == "" {
+		status = "unknown"
+	}
+	verb := "timed out"
+	if errors.Is(e.Err, context.Canceled) {
+		verb = "canceled"
+	}
+	return fmt.Sprintf("%s after %s waiting for deployment %s to %s (last observed status: %s)",
+		verb, e.Elapsed.Round(time.Second), e.DeploymentID, e.Operation, status)
+}
+
+// Unwrap exposes the context error so callers can use errors.Is with
+// context.DeadlineExceeded or context.Canceled.
+func (e *TimeoutError) Unwrap() error {
+	return e.Err
+}
+
+// newTimeoutError builds a TimeoutError for a wait loop that started at start.
+// When ctx is still live the loop hit its own fallback deadline, which is
+// reported as context.DeadlineExceeded.
+func newTimeoutError(ctx context.Context, operation, deploymentID, lastStatus string, start time.Time) *TimeoutError {
+	err := ctx.Err()
+	if err == nil {
+		err = context.DeadlineExceeded
+	}
+	return &TimeoutError{
+		Operation:    operation,
+		DeploymentID: deploymentID,
+		LastStatus:   lastStatus,
+		Elapsed:      time.Since(start),
+		Err:          err,
+	}
+}
+
+// deadlineOrDefault returns ctx's deadline, or now+fallback when ctx has none.
+// Wait loops use it so a resource's configured timeouts bound (or extend) the
+// wait, while calls made without a deadline keep the historical limits.
+func deadlineOrDefault(ctx context.Context, fallback time.Duration) time.Time {
+	if deadline, ok := ctx.Deadline(); ok {
+		return deadline
+	}
+	return time.Now().Add(fallback)
 }
 
 // isTransient reports whether err is worth retrying: an HTTP 5xx response,
@@ -98,47 +208,117 @@ func isTransient(err error) bool {
 	if err == nil {
 		return false
 	}
-	var httpErr *HTTPStatusError
-	if errors.As(err, &httpErr) {
-		if httpErr.StatusCode == http.StatusBadRequest {
-			body := strings.ToLower(httpErr.Body)
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		if apiErr.StatusCode == http.StatusBadRequest {
+			body := strings.ToLower(apiErr.Body)
 			if strings.Contains(body, "deployment currently updating another change") {
 				return true
 			}
 		}
-		return httpErr.StatusCode >= 500
+		return apiErr.StatusCode >= 500
 	}
 	// Non-HTTP errors are typically network-level and worth retrying.
 	return true
 }
 
-// doRequest - send the Request.
+// doRequest - send the Request, retrying retryable failures (see
+// shouldRetry) up to c.MaxRetries times with exponential backoff and jitter.
+// A 401 caused by an expired or revoked token is answered by signing in again
+// once and replaying the request.
 func (c *Client) doRequest(req *http.Request) ([]byte, error) {
-	token := c.Token
+	ctx := req.Context()
+	refreshed := false
+	for attempt := 0; ; attempt++ {
+		token := c.currentToken()
+		body, err := c.doRequestOnce(req, token)
+		if !refreshed && c.canRefreshToken(req, err) {
+			refreshed = true
+			if refreshErr := c.refreshToken(ctx, token); refreshErr != nil {
+				return nil, fmt.Errorf("%w (signing in again failed: %v)", err, refreshErr)
+			}
+			if !rewindBody(req) {
+				return nil, err
+			}
+			body, err = c.doRequestOnce(req, c.currentToken())
+		}
+		if err == nil || attempt >= c.MaxRetries || ctx.Err() != nil || !shouldRetry(req, err) {
+			return body, err
+		}
+		delay := retryDelay(attempt, err, c.RetryMaxWait)
+		if !fitsDeadline(ctx, delay) || !rewindBody(req) {
+			return nil, err
+		}
+		tflog.SubsystemDebug(httpLogContext(ctx), httpLogSubsystem, "Retrying SearchStax API request", map[string]interface{}{
+			"http_method": req.Method,
+			"http_url":    req.URL.String(),
+			"attempt":     attempt + 1,
+			"delay":       delay.String(),
+			"error":       err.Error(),
+		})
+		if sleepContext(ctx, delay) != nil {
+			return nil, err
+		}
+	}
+}
 
+// doRequestOnce sends req a single time, authenticated with token.
+func (c *Client) doRequestOnce(req *http.Request, token string) ([]byte, error) {
 	// Preserve a Content-Type that the caller already set (e.g. the
 	// multipart/form-data boundary used for file uploads); default to JSON.
 	if req.Header.Get("Content-Type") == "" {
 		req.Header.Set("Content-Type", "application/json")
 	}
-	if token != "" {
+	switch {
+	case isSignInRequest(req):
+	case c.APIKey != "":
+		req.Header.Set("Authorization", fmt.Sprintf("APIkey %s", c.APIKey))
+	case token != "":
 		req.Header.Set("Authorization", fmt.Sprintf("Token %s", token))
 	}
 
+	release, err := c.throttle(req.Context(), req)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	logCtx := httpLogContext(req.Context())
+	logRequest(logCtx, req)
+	start := time.Now()
+
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
+		logResponse(logCtx, req, nil, nil, err, start)
 		return nil, err
 	}
 	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
+	logResponse(logCtx, req, res, body, err, start)
 	if err != nil {
 		return nil, err
 	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return nil, &HTTPStatusError{StatusCode: res.StatusCode, Body: string(body)}
+		apiErr := newAPIError(res.StatusCode, body)
+		apiErr.retryAfter = parseRetryAfter(res)
+		return nil, apiErr
 	}
 
 	return body, err
 }
+
+// sleepContext pauses for d, returning early with ctx.Err() if ctx is
+// canceled or its deadline passes first. Polling loops use it instead of
+// time.Sleep so an interrupted Terraform run stops waiting promptly.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -14,7 +14,7 @@ func (c *Error) Error() string {
 }
 
 // Unwrap exposes the wrapped error so callers can use errors.As/errors.Is
-// to inspect the underlying cause (e.g. an *HTTPStatusError).
+// to inspect the underlying cause (e.g. an *APIError).
 func (c *Error) Unwrap() error {
 	return c.err
 }
@@ -2,6 +2,7 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -66,8 +67,8 @@ func (l *CustomJarsList) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (c *Client) GetCustomJars(accountName, deploymentID string) (*CustomJarsList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/solr/custom-jars/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetCustomJars(ctx context.Context, accountName, deploymentID string) (*CustomJarsList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/solr/custom-jars/", c.HostURL, accountName, deploymentID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -89,22 +90,28 @@ func (c *Client) GetCustomJars(accountName, deploymentID string) (*CustomJarsLis
 //     it via multipart/form-data.
 //   - neither set: send a JSON metadata payload (used by the mock API in
 //     acceptance tests).
-func (c *Client) UploadCustomJar(accountName, deploymentID string, jar CustomJar) error {
+func (c *Client) UploadCustomJar(ctx context.Context, accountName, deploymentID string, jar CustomJar) error {
+	ctx, unlock, err := c.lockDeployment(ctx, accountName, deploymentID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	url := fmt.Sprintf("%s/account/%s/deployment/%s/solr/custom-jars/", c.HostURL, accountName, deploymentID)
 
 	switch {
 	case jar.FilePath != "":
-		return c.uploadCustomJarFile(url, jar.FilePath)
+		return c.uploadCustomJarFile(ctx, url, jar.FilePath)
 	case jar.SourceURL != "":
-		return c.uploadCustomJarURL(url, jar.SourceURL, jar.Name)
+		return c.uploadCustomJarURL(ctx, url, jar.SourceURL, jar.Name)
 	default:
-		return c.uploadCustomJarJSON(url, jar)
+		return c.uploadCustomJarJSON(ctx, url, jar)
 	}
 }
 
 // uploadCustomJarURL downloads the .jar file from an http(s) URL and uploads
 // it to the deployment via multipart/form-data.
-func (c *Client) uploadCustomJarURL(url, sourceURL, name string) error {
+func (c *Client) uploadCustomJarURL(ctx context.Context, url, sourceURL, name string) error {
 	u, err := neturl.Parse(sourceURL)
 	if err != nil {
 		return fmt.Errorf("parsing custom jar source_url %q: %w", sourceURL, err)
@@ -113,7 +120,7 @@ func (c *Client) uploadCustomJarURL(url, sourceURL, name string) error {
 		return fmt.Errorf("custom jar source_url must be an http or https URL, got %q", sourceURL)
 	}
 
-	getReq, err := http.NewRequest("GET", sourceURL, nil)
+	getReq, err := http.NewRequestWithContext(ctx, "GET", sourceURL, nil)
 	if err != nil {
 		return err
 	}
@@ -144,7 +151,7 @@ func (c *Client) uploadCustomJarURL(url, sourceURL, name string) error {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", url, &buf)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, &buf)
 	if err != nil {
 		return err
 	}
@@ -156,7 +163,7 @@ func (c *Client) uploadCustomJarURL(url, sourceURL, name string) error {
 }
 
 // uploadCustomJarFile performs the multipart/form-data file upload.
-func (c *Client) uploadCustomJarFile(url, filePath string) error {
+func (c *Client) uploadCustomJarFile(ctx context.Context, url, filePath string) error {
 	file, err := os.Open(filePath)
 	if err != nil {
 		return fmt.Errorf("opening custom jar %q: %w", filePath, err)
@@ -176,7 +183,7 @@ func (c *Client) uploadCustomJarFile(url, filePath string) error {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", url, &buf)
+	req, err := http.NewRequestWithContext(ctx, "POST", url, &buf)
 	if err != nil {
 		return err
 	}
@@ -189,12 +196,12 @@ func (c *Client) uploadCustomJarFile(url, filePath string) error {
 }
 
 // uploadCustomJarJSON sends a JSON metadata payload (mock API path).
-func (c *Client) uploadCustomJarJSON(url string, jar CustomJar) error {
+func (c *Client) uploadCustomJarJSON(ctx context.Context, url string, jar CustomJar) error {
 	rb, err := json.Marshal(jar)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", url, strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -206,12 +213,18 @@ func (c *Client) uploadCustomJarJSON(url string, jar CustomJar) error {
 	return nil
 }
 
-func (c *Client) DeleteCustomJar(accountName, deploymentID, jarName string) error {
+func (c *Client) DeleteCustomJar(ctx context.Context, accountName, deploymentID, jarName string) error {
+	ctx, unlock, err := c.lockDeployment(ctx, accountName, deploymentID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	// The real API returns a 500 (not a 404) when asked to delete a jar that
 	// is no longer installed, so first check whether the jar is still present.
 	// If it is already gone, deletion is a no-op (matches the Python module,
 	// which GETs the jar list and returns success when the jar is not found).
-	if jars, err := c.GetCustomJars(accountName, deploymentID); err == nil {
+	if jars, err := c.GetCustomJars(ctx, accountName, deploymentID); err == nil {
 		installed := false
 		for _, j := range jars.Results {
 			if j.Name == jarName {
@@ -224,7 +237,7 @@ func (c *Client) DeleteCustomJar(accountName, deploymentID, jarName string) erro
 		}
 	}
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/account/%s/deployment/%s/solr/custom-jars/%s/", c.HostURL, accountName, deploymentID, jarName), nil)
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/account/%s/deployment/%s/solr/custom-jars/%s/", c.HostURL, accountName, deploymentID, jarName), nil)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// heldDeploymentLocks is the context key under which lockDeployment records
+// the deployment locks held by the calling operation.
+type heldDeploymentLocks struct{}
+
+// lockDeployment serializes mutating operations on one deployment: calls for
+// the same account/deployment UID queue up, while other deployments proceed in
+// parallel. It returns a context that marks the lock as held, so nested calls
+// made with it (UpdateDeploymentUser calling DeleteDeploymentUser, for
+// example) do not wait on themselves, and the func that releases the lock.
+// Time spent waiting for another operation is logged.
+func (c *Client) lockDeployment(ctx context.Context, accountName, deploymentID string) (context.Context, func(), error) {
+	key := accountName + "/" + deploymentID
+	if holdsDeploymentLock(ctx, key) {
+		return ctx, func() {}, nil
+	}
+
+	fields := map[string]interface{}{
+		"account_name":   accountName,
+		"deployment_uid": deploymentID,
+	}
+	unlock, ok := c.deploymentLocks.tryLock(key)
+	if !ok {
+		tflog.Debug(ctx, "Waiting for another change to the deployment to finish", fields)
+		start := time.Now()
+		var err error
+		unlock, err = c.deploymentLocks.lock(ctx, key)
+		if err != nil {
+			return ctx, nil, err
+		}
+		fields["wait"] = time.Since(start).Round(time.Millisecond).String()
+		tflog.Info(ctx, "Waited for another change to the deployment to finish", fields)
+	}
+
+	held, _ := ctx.Value(heldDeploymentLocks{}).(map[string]bool)
+	next := make(map[string]bool, len(held)+1)
+	for k := range held {
+		next[k] = true
+	}
+	next[key] = true
+	return context.WithValue(ctx, heldDeploymentLocks{}, next), unlock, nil
+}
+
+// holdsDeploymentLock reports whether ctx was returned by lockDeployment for
+// key ("<account>/<uid>"), i.e. the caller already holds that deployment's
+// lock.
+func holdsDeploymentLock(ctx context.Context, key string) bool {
+	held, _ := ctx.Value(heldDeploymentLocks{}).(map[string]bool)
+	return held[key]
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -15,8 +16,8 @@ type DeploymentHealth struct {
 	DeploymentUID string `json:"deployment_uid"`
 }
 
-func (c *Client) GetDeploymentHealth(accountName, deploymentID string) (*DeploymentHealth, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/deployment-health/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetDeploymentHealth(ctx context.Context, accountName, deploymentID string) (*DeploymentHealth, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/deployment-health/", c.HostURL, accountName, deploymentID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -39,8 +40,8 @@ type CollectionsHealth struct {
 	Collections   []string `json:"collections"`
 }
 
-func (c *Client) GetCollectionsHealth(accountName, deploymentID string) (*CollectionsHealth, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/collection-health/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetCollectionsHealth(ctx context.Context, accountName, deploymentID string) (*CollectionsHealth, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/collection-health/", c.HostURL, accountName, deploymentID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -71,8 +72,8 @@ type DeploymentServer struct {
 	Role           string `json:"role"`
 }
 
-func (c *Client) GetDeploymentServers(accountName, deploymentID string) (*DeploymentServersList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/server/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetDeploymentServers(ctx context.Context, accountName, deploymentID string) (*DeploymentServersList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/server/", c.HostURL, accountName, deploymentID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -98,8 +99,8 @@ type ServerHostStatus struct {
 	Node   string `json:"node"`
 }
 
-func (c *Client) GetServerHostStatus(accountName, deploymentID, node string) (*ServerHostStatus, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/server/%s/host-status/", c.HostURL, accountName, deploymentID, node), nil)
+func (c *Client) GetServerHostStatus(ctx context.Context, accountName, deploymentID, node string) (*ServerHostStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/server/%s/host-status/", c.HostURL, accountName, deploymentID, node), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -125,12 +126,12 @@ type RollingRestartResponse struct {
 	Queued  bool   `json:"queued"`
 }
 
-func (c *Client) RollingRestart(accountName, deploymentID string, reqBody RollingRestartRequest) (*RollingRestartResponse, error) {
+func (c *Client) RollingRestart(ctx context.Context, accountName, deploymentID string, reqBody RollingRestartRequest) (*RollingRestartResponse, error) {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("PUT", fmt.Sprintf("%s/account/%s/deployment/%s/rolling-restart/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PUT", fmt.Sprintf("%s/account/%s/deployment/%s/rolling-restart/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +147,7 @@ func (c *Client) RollingRestart(accountName, deploymentID string, reqBody Rollin
 	// Wait until the deployment is healthy again so the rolling restart is
 	// fully complete before returning (mirrors the reference Python module,
 	// which polls deployment-health until status == "OK").
-	if err := c.waitForDeploymentHealthy(accountName, deploymentID); err != nil {
+	if err := c.waitForDeploymentHealthy(ctx, accountName, deploymentID); err != nil {
 		return nil, err
 	}
 
@@ -158,21 +159,26 @@ func (c *Client) RollingRestart(accountName, deploymentID string, reqBody Rollin
 // after a restart is triggered, so this first waits for the restart to begin
 // (health leaves the healthy state) before waiting for it to recover. It polls
 // every pollInterval and reports the deployment healthy when the status is
-// "OK" (real API) or "Healthy" (mock API).
-func (c *Client) waitForDeploymentHealthy(accountName, deploymentID string) error {
+// "OK" (real API) or "Healthy" (mock API). The recovery wait is bounded by
+// ctx's deadline (the resource's timeout), falling back to maxWait.
+func (c *Client) waitForDeploymentHealthy(ctx context.Context, accountName, deploymentID string) error {
 	const (
 		pollInterval = 10 * time.Second
 		startGrace   = 90 * time.Second // max wait for the restart to begin
 		maxWait      = 30 * time.Minute // max wait for the restart to finish
 	)
 
+	const operation = "become healthy after rolling restart"
+	lastStatus := ""
 	isHealthy := func() bool {
-		health, err := c.GetDeploymentHealth(accountName, deploymentID)
+		health, err := c.GetDeploymentHealth(ctx, accountName, deploymentID)
 		if err != nil {
 			// A transient error / 502 while the cluster is restarting counts
 			// as "not healthy".
+			lastStatus = "unreachable"
 			return false
 		}
+		lastStatus = health.Status
 		switch strings.ToLower(health.Status) {
 		case "ok", "healthy":
 			return true
@@ -189,29 +195,34 @@ func (c *Client) waitForDeploymentHealthy(accountName, deploymentID string) erro
 	// Phase 1: wait for the rolling restart to actually begin. If the
 	// deployment never leaves the healthy state within the grace window, assume
 	// the restart was quick and proceed.
+	start := time.Now()
 	graceDeadline := time.Now().Add(startGrace)
 	for time.Now().Before(graceDeadline) {
 		if !isHealthy() {
 			break
 		}
-		time.Sleep(pollInterval)
+		if err := sleepContext(ctx, pollInterval); err != nil {
+			return newTimeoutError(ctx, operation, deploymentID, lastStatus, start)
+		}
 	}
 
 	// Phase 2: wait until the deployment is healthy again.
-	deadline := time.Now().Add(maxWait)
+	deadline := deadlineOrDefault(ctx, maxWait)
 	for {
 		if isHealthy() {
 			return nil
 		}
 		if time.Now().After(deadline) {
-			return fmt.Errorf("timed out after %s waiting for deployment %s to become healthy after rolling restart", maxWait, deploymentID)
+			return newTimeoutError(ctx, operation, deploymentID, lastStatus, start)
+		}
+		if err := sleepContext(ctx, pollInterval); err != nil {
+			return newTimeoutError(ctx, operation, deploymentID, lastStatus, start)
 		}
-		time.Sleep(pollInterval)
 	}
 }
 
-func (c *Client) StartSolr(accountName, deploymentID, node string) error {
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/server/%s/start-solr/", c.HostURL, accountName, deploymentID, node), nil)
+func (c *Client) StartSolr(ctx context.Context, accountName, deploymentID, node string) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/server/%s/start-solr/", c.HostURL, accountName, deploymentID, node), nil)
 	if err != nil {
 		return err
 	}
@@ -232,8 +243,8 @@ func (c *Client) StartSolr(accountName, deploymentID, node string) error {
 	return nil
 }
 
-func (c *Client) StopSolr(accountName, deploymentID, node string) error {
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/server/%s/stop-solr/", c.HostURL, accountName, deploymentID, node), nil)
+func (c *Client) StopSolr(ctx context.Context, accountName, deploymentID, node string) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/server/%s/stop-solr/", c.HostURL, accountName, deploymentID, node), nil)
 	if err != nil {
 		return err
 	}
@@ -311,7 +322,25 @@ func (p *Plan) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (c *Client) GetPlans(accountName, application, planType string, page int) (*PlansList, error) {
+func (c *Client) GetPlans(ctx context.Context, accountName, application, planType string, page int) (*PlansList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.plansURL(accountName, application, planType, page), nil)
+	if err != nil {
+		return nil, err
+	}
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	out := PlansList{}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return nil, err
+	}
+	normalizePlanNames(out.Results)
+	return &out, nil
+}
+
+// plansURL builds the plan list URL; page 0 leaves the page unset.
+func (c *Client) plansURL(accountName, application, planType string, page int) string {
 	q := url.Values{}
 	if page > 0 {
 		q.Set("page", fmt.Sprintf("%d", page))
@@ -326,50 +355,38 @@ func (c *Client) GetPlans(accountName, application, planType string, page int) (
 	if encoded := q.Encode(); encoded != "" {
 		reqURL += "?" + encoded
 	}
-	req, err := http.NewRequest("GET", reqURL, nil)
-	if err != nil {
-		return nil, err
-	}
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	out := PlansList{}
-	if err := json.Unmarshal(body, &out); err != nil {
-		return nil, err
-	}
-	for i := range out.Results {
-		if out.Results[i].Name == "" {
-			out.Results[i].Name = out.Results[i].Plan
+	return reqURL
+}
+
+// normalizePlanNames fills Name from Plan for plans returned without one.
+func normalizePlanNames(plans []Plan) {
+	for i := range plans {
+		if plans[i].Name == "" {
+			plans[i].Name = plans[i].Plan
 		}
 	}
-	return &out, nil
 }
 
 // GetAllPlans fetches every page of plans and returns them in a single PlansList.
-func (c *Client) GetAllPlans(accountName, application, planType string) (*PlansList, error) {
+func (c *Client) GetAllPlans(ctx context.Context, accountName, application, planType string) (*PlansList, error) {
+	plans, err := listAll(ctx, c, c.plansURL(accountName, application, planType, 1), decodeListPage[Plan](""))
+	if err != nil {
+		return nil, err
+	}
+	normalizePlanNames(plans)
+
+	// Pages can overlap when the catalog changes while it is being read.
 	var allResults []Plan
 	seen := make(map[string]bool)
-	page := 1
-	for page <= 100 { // safety limit
-		out, err := c.GetPlans(accountName, application, planType, page)
-		if err != nil {
-			return nil, err
+	for _, p := range plans {
+		key := p.Plan
+		if key == "" {
+			key = p.Name
 		}
-		for _, p := range out.Results {
-			key := p.Plan
-			if key == "" {
-				key = p.Name
-			}
-			if !seen[key] {
-				seen[key] = true
-				allResults = append(allResults, p)
-			}
-		}
-		if len(out.Results) == 0 || out.Next == "" {
-			break
+		if !seen[key] {
+			seen[key] = true
+			allResults = append(allResults, p)
 		}
-		page++
 	}
 	return &PlansList{
 		Count:   int32(len(allResults)),
@@ -394,8 +411,8 @@ type UsageItem struct {
 	TagCollection []string `json:"tagCollection"`
 }
 
-func (c *Client) GetUsage(accountName string, year, month int) (*UsageList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/usage/%d/%d/", c.HostURL, accountName, year, month), nil)
+func (c *Client) GetUsage(ctx context.Context, accountName string, year, month int) (*UsageList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/usage/%d/%d/", c.HostURL, accountName, year, month), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -434,8 +451,8 @@ type UsageExtendedList struct {
 	Results []UsageExtendedItem `json:"results"`
 }
 
-func (c *Client) GetUsageExtended(accountName string, year, month int) (*UsageExtendedList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/usage-extended/%d/%d/", c.HostURL, accountName, year, month), nil)
+func (c *Client) GetUsageExtended(ctx context.Context, accountName string, year, month int) (*UsageExtendedList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/usage-extended/%d/%d/", c.HostURL, accountName, year, month), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestScaleDeployment(t *testing.T) {
+	defer func(interval time.Duration) { deploymentScalePollInterval = interval }(deploymentScalePollInterval)
+	deploymentScalePollInterval = time.Millisecond
+
+	t.Run("waits for desired tier to become tier", func(t *testing.T) {
+		var scaleBody map[string]any
+		var polls atomic.Int32
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.Method == "PUT" && r.URL.Path == "/account/acct/deployment/ss1/scale/":
+				body, _ := io.ReadAll(r.Body)
+				_ = json.Unmarshal(body, &scaleBody)
+				_, _ = w.Write([]byte(`{"success": "true"}`))
+			case r.Method == "GET" && r.URL.Path == "/account/acct/deployment/ss1/":
+				if polls.Add(1) < 3 {
+					_, _ = w.Write([]byte(`{"uid": "ss1", "status": "Running", "provision_state": "Pending", "tier": "NDC4", "desired_tier": "NDC8"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"uid": "ss1", "status": "Running", "provision_state": "Done", "tier": "NDC8", "desired_tier": "NDC8"}`))
+			default:
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		})
+
+		nodes := int64(2)
+		dep, err := c.ScaleDeployment(context.Background(), "acct", "ss1", DeploymentScaleRequest{Plan: "NDC8-GCP-G", NumAdditionalAppNodes: &nodes})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if dep.Tier != "NDC8" {
+			t.Errorf("tier = %q, want NDC8", dep.Tier)
+		}
+		if got := polls.Load(); got != 3 {
+			t.Errorf("polled %d times, want 3", got)
+		}
+		if scaleBody["plan"] != "NDC8-GCP-G" || scaleBody["num_additional_app_nodes"] != float64(2) {
+			t.Errorf("scale request = %v", scaleBody)
+		}
+		if _, ok := scaleBody["num_additional_zookeeper_nodes"]; ok {
+			t.Errorf("unchanged zookeeper node count was sent: %v", scaleBody)
+		}
+	})
+
+	t.Run("failed status is an error", func(t *testing.T) {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "PUT" {
+				_, _ = w.Write([]byte(`{}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"uid": "ss1", "status": "Failed", "tier": "NDC4", "desired_tier": "NDC8"}`))
+		})
+
+		_, err := c.ScaleDeployment(context.Background(), "acct", "ss1", DeploymentScaleRequest{Plan: "NDC8-GCP-G"})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+
+	t.Run("timeout names the pending tier", func(t *testing.T) {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "PUT" {
+				_, _ = w.Write([]byte(`{}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"uid": "ss1", "status": "Running", "provision_state": "Pending", "tier": "NDC4", "desired_tier": "NDC8"}`))
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := c.ScaleDeployment(ctx, "acct", "ss1", DeploymentScaleRequest{Plan: "NDC8-GCP-G"})
+		var timeoutErr *TimeoutError
+		if !errors.As(err, &timeoutErr) {
+			t.Fatalf("expected a TimeoutError, got %v", err)
+		}
+		if timeoutErr.LastStatus != "Running/Pending, tier NDC4 -> NDC8" {
+			t.Errorf("last status = %q", timeoutErr.LastStatus)
+		}
+	})
+}
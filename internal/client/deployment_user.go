@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -9,8 +10,8 @@ import (
 )
 
 // GetDeploymentUsers returns the list of Solr Basic Auth users for a deployment.
-func (c *Client) GetDeploymentUsers(accountName string, deploymentID string) (*DeploymentUsersList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/get-users/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetDeploymentUsers(ctx context.Context, accountName string, deploymentID string) (*DeploymentUsersList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/get-users/", c.HostURL, accountName, deploymentID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -32,8 +33,8 @@ func (c *Client) GetDeploymentUsers(accountName string, deploymentID string) (*D
 // GetDeploymentUser returns details of a specific Solr Basic Auth user.
 //
 // The mock API does not expose a per-user read endpoint; we filter from the list.
-func (c *Client) GetDeploymentUser(accountName string, deploymentID string, username string) (*DeploymentUser, error) {
-	users, err := c.GetDeploymentUsers(accountName, deploymentID)
+func (c *Client) GetDeploymentUser(ctx context.Context, accountName string, deploymentID string, username string) (*DeploymentUser, error) {
+	users, err := c.GetDeploymentUsers(ctx, accountName, deploymentID)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +48,16 @@ func (c *Client) GetDeploymentUser(accountName string, deploymentID string, user
 }
 
 // CreateDeploymentUser creates a new Solr Basic Auth user for the deployment.
-func (c *Client) CreateDeploymentUser(deploymentUser DeploymentUser, accountName string, deploymentID string) (*DeploymentUser, *Error) {
+func (c *Client) CreateDeploymentUser(ctx context.Context, deploymentUser DeploymentUser, accountName string, deploymentID string) (*DeploymentUser, *Error) {
+	ctx, unlock, lockErr := c.lockDeployment(ctx, accountName, deploymentID)
+	if lockErr != nil {
+		return nil, &Error{
+			err:     lockErr,
+			context: "LockDeployment",
+		}
+	}
+	defer unlock()
+
 	rb, err := json.Marshal(deploymentUser)
 	if err != nil {
 		return nil, &Error{
@@ -56,44 +66,21 @@ func (c *Client) CreateDeploymentUser(deploymentUser DeploymentUser, accountName
 		}
 	}
 
-	// Enabling basic auth triggers a Solr restart that may still be settling
-	// when add-user runs, causing transient 5xx responses. Retry on transient
-	// errors to ride out the restart (skip the long backoff against the mock).
-	const (
-		attempts = 10
-		backoff  = 15 * time.Second
-	)
-
-	var body []byte
-	var lastErr error
-	for i := 0; i < attempts; i++ {
-		req, reqErr := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/add-user/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
-		if reqErr != nil {
-			return nil, &Error{
-				err:     reqErr,
-				context: "NewRequest",
-			}
-		}
-
-		body, err = c.doRequest(req)
-		if err == nil {
-			break
-		}
-		if isTransient(err) && !c.isMockHost() {
-			lastErr = err
-			if i < attempts-1 {
-				time.Sleep(backoff)
-			}
-			continue
-		}
+	// add-user is not replayed after a 5xx: the user may already have been
+	// created, and a second attempt would fail as a duplicate. doRequest
+	// still retries 429 and "currently updating another change" rejections.
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/add-user/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
+	if err != nil {
 		return nil, &Error{
 			err:     err,
-			context: "doRequest",
+			context: "NewRequest",
 		}
 	}
+
+	body, err := c.doRequest(req)
 	if err != nil {
 		return nil, &Error{
-			err:     lastErr,
+			err:     err,
 			context: "doRequest",
 		}
 	}
@@ -116,8 +103,19 @@ func (c *Client) CreateDeploymentUser(deploymentUser DeploymentUser, accountName
 }
 
 // UpdateDeploymentUser updates a deployment user by deleting then re-adding.
-func (c *Client) UpdateDeploymentUser(accountName string, deploymentID string, deploymentUser DeploymentUser) (*DeploymentUser, *Error) {
-	err := c.DeleteDeploymentUser(accountName, deploymentID, deploymentUser.Username)
+func (c *Client) UpdateDeploymentUser(ctx context.Context, accountName string, deploymentID string, deploymentUser DeploymentUser) (*DeploymentUser, *Error) {
+	// Hold the deployment lock across the delete and re-add so no other change
+	// to the deployment slips in between.
+	ctx, unlock, lockErr := c.lockDeployment(ctx, accountName, deploymentID)
+	if lockErr != nil {
+		return nil, &Error{
+			err:     lockErr,
+			context: "LockDeployment",
+		}
+	}
+	defer unlock()
+
+	err := c.DeleteDeploymentUser(ctx, accountName, deploymentID, deploymentUser.Username)
 	if err != nil {
 		return nil, &Error{
 			err:     err,
@@ -125,8 +123,13 @@ func (c *Client) UpdateDeploymentUser(accountName string, deploymentID string, d
 		}
 	}
 	// Sleep for 5 seconds to wait until the deployment user is deleted.
-	time.Sleep(time.Second * 5)
-	newDeployment, err := c.CreateDeploymentUser(deploymentUser, accountName, deploymentID)
+	if sleepErr := sleepContext(ctx, 5*time.Second); sleepErr != nil {
+		return nil, &Error{
+			err:     sleepErr,
+			context: "UpdateDeploymentUserCanceled",
+		}
+	}
+	newDeployment, err := c.CreateDeploymentUser(ctx, deploymentUser, accountName, deploymentID)
 	if err != nil {
 		return nil, &Error{
 			err:     err,
@@ -137,7 +140,16 @@ func (c *Client) UpdateDeploymentUser(accountName string, deploymentID string, d
 }
 
 // DeleteDeploymentUser deletes a deployment user.
-func (c *Client) DeleteDeploymentUser(accountName string, deploymentID string, username string) *Error {
+func (c *Client) DeleteDeploymentUser(ctx context.Context, accountName string, deploymentID string, username string) *Error {
+	ctx, unlock, lockErr := c.lockDeployment(ctx, accountName, deploymentID)
+	if lockErr != nil {
+		return &Error{
+			err:     lockErr,
+			context: "LockDeployment",
+		}
+	}
+	defer unlock()
+
 	userToDelete, err := json.Marshal(map[string]interface{}{
 		"username": username,
 	})
@@ -147,7 +159,7 @@ func (c *Client) DeleteDeploymentUser(accountName string, deploymentID string, u
 			context: "NewRequestOnDelete",
 		}
 	}
-	req, err := http.NewRequest("POST",
+	req, err := http.NewRequestWithContext(ctx, "POST",
 		fmt.Sprintf("%s/account/%s/deployment/%s/solr/auth/delete-user/",
 			c.HostURL, accountName, deploymentID), strings.NewReader(string(userToDelete)))
 	if err != nil {
@@ -185,9 +197,11 @@ func (c *Client) DeleteDeploymentUser(accountName string, deploymentID string, u
 			context: "ApiResponseOnDelete",
 		}
 	}
-	//Check the resource status in a loop until it got deleted
+	// Check the resource status in a loop until it got deleted. The wait is
+	// bounded by ctx, which carries the resource's delete timeout.
+	start := time.Now()
 	for {
-		dep, err := c.GetDeployment(accountName, deploymentID)
+		dep, err := c.GetDeployment(ctx, accountName, deploymentID)
 		if err != nil {
 			fmt.Printf("Deployment Deleted successfully: %v\n", err)
 			return nil
@@ -197,7 +211,12 @@ func (c *Client) DeleteDeploymentUser(accountName string, deploymentID string, u
 			return nil
 		}
 
-		time.Sleep(time.Minute)
+		if sleepErr := sleepContext(ctx, time.Minute); sleepErr != nil {
+			return &Error{
+				context: "DeleteDeploymentUserTimeout",
+				err:     newTimeoutError(ctx, fmt.Sprintf("remove basic auth user %q", username), deploymentID, deploymentStatusSummary(dep), start),
+			}
+		}
 	}
 }
 
@@ -11,29 +11,21 @@ import (
 )
 
 // GetDeployments - Returns list of datasources (no auth required).
-func (c *Client) GetDeployments(accountName string) (*DeploymentsList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/", c.HostURL, accountName), nil)
+func (c *Client) GetDeployments(ctx context.Context, accountName string) (*DeploymentsList, error) {
+	deployments, err := listAll(ctx, c, fmt.Sprintf("%s/account/%s/deployment/", c.HostURL, accountName), decodeListPage[Deployment](""))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	deployments := DeploymentsList{}
-	err = json.Unmarshal(body, &deployments)
-	if err != nil {
-		return nil, err
-	}
-
-	return &deployments, nil
+	return &DeploymentsList{
+		Count:   int32(len(deployments)),
+		Results: deployments,
+	}, nil
 }
 
 // GetDeployment - Returns specific deployment (no auth required).
-func (c *Client) GetDeployment(accountName string, deploymentID string) (*Deployment, *Error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetDeployment(ctx context.Context, accountName string, deploymentID string) (*Deployment, *Error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/", c.HostURL, accountName, deploymentID), nil)
 	if err != nil {
 		return nil, &Error{
 			err:     err,
@@ -78,8 +70,9 @@ type deploymentCreateRequest struct {
 	PrivateVpc            *int64 `json:"private_vpc,omitempty"`
 }
 
-// CreateDeployment - Create new deployment.
-func (c *Client) CreateDeployment(deployment Deployment, accountName string) (*Deployment, *Error) {
+// StartDeployment - Request a new deployment and return it as soon as
+// SearchStax has assigned its UID, without waiting for it to be provisioned.
+func (c *Client) StartDeployment(ctx context.Context, deployment Deployment, accountName string) (*Deployment, *Error) {
 	payload := deploymentCreateRequest{
 		Name:                  deployment.Name,
 		Application:           deployment.Application,
@@ -104,7 +97,7 @@ func (c *Client) CreateDeployment(deployment Deployment, accountName string) (*D
 		}
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/", c.HostURL, accountName), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/", c.HostURL, accountName), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, &Error{
 			err:     err,
@@ -128,15 +121,34 @@ func (c *Client) CreateDeployment(deployment Deployment, accountName string) (*D
 			context: "Unmarshal",
 		}
 	}
-	//Check the resource status in a loop until it becomes "Done"
+	return &newDeployment, nil
+}
+
+// WaitForDeploymentReady - Wait until a deployment started by
+// StartDeployment is running, and return it with its status and endpoint
+// filled in.
+func (c *Client) WaitForDeploymentReady(ctx context.Context, accountName string, deployment *Deployment) (*Deployment, *Error) {
+	newDeployment := *deployment
+
+	// Check the resource status in a loop until it becomes "Done". The wait is
+	// bounded only by ctx, which carries the resource's create timeout.
+	start := time.Now()
+	lastStatus := ""
 	for {
-		dep, err := c.GetDeployment(accountName, newDeployment.UID)
+		dep, err := c.GetDeployment(ctx, accountName, newDeployment.UID)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, &Error{
+					err:     newTimeoutError(ctx, "finish provisioning", newDeployment.UID, lastStatus, start),
+					context: "CreateDeploymentTimeout",
+				}
+			}
 			return nil, &Error{
 				err:     err,
 				context: "GetDeploymentStatus",
 			}
 		}
+		lastStatus = deploymentStatusSummary(dep)
 
 		if dep.Status == "Running" && dep.ProvisionState == "Done" {
 			newDeployment.Status = dep.Status
@@ -152,31 +164,24 @@ func (c *Client) CreateDeployment(deployment Deployment, accountName string) (*D
 			}
 		}
 
-		time.Sleep(time.Minute)
+		if sleepErr := sleepContext(ctx, time.Minute); sleepErr != nil {
+			return nil, &Error{
+				err:     newTimeoutError(ctx, "finish provisioning", newDeployment.UID, lastStatus, start),
+				context: "CreateDeploymentTimeout",
+			}
+		}
 	}
 
 	return &newDeployment, nil
 }
 
-// UpdateDeployment -Update a deployment: for now it recreate the cluster.
-func (c *Client) UpdateDeployment(accountName string, deploymentID string, deployment Deployment) (*Deployment, *Error) {
-	err := c.DeleteDeployment(context.Background(), accountName, deploymentID)
-	if err != nil {
-		return nil, &Error{
-			err:     err,
-			context: "DeleteDeploymentOnUpdate",
-		}
+// deploymentStatusSummary formats the status fields reported while a
+// deployment is provisioning or being deleted, e.g. "Running/Pending".
+func deploymentStatusSummary(dep *Deployment) string {
+	if dep.ProvisionState == "" {
+		return dep.Status
 	}
-	// Sleep for 1 minutes to wait until the deployment is deleted.
-	time.Sleep(time.Minute)
-	newDeployment, err := c.CreateDeployment(deployment, accountName)
-	if err != nil {
-		return nil, &Error{
-			err:     err,
-			context: "CreateDeploymentOnUpdate",
-		}
-	}
-	return newDeployment, nil
+	return dep.Status + "/" + dep.ProvisionState
 }
 
 // DeleteDeployment -Delete a specific deployment.
@@ -215,46 +220,54 @@ func (c *Client) DeleteDeployment(ctx context.Context, accountName string, deplo
 	// timeout elapses. Only a definitive 404 confirms deletion — a transient
 	// error (network blip, 5xx, expired token) must NOT be treated as success,
 	// otherwise Terraform would drop the resource from state while it still
-	// exists, leaving orphaned infrastructure.
+	// exists, leaving orphaned infrastructure. The wait honors ctx's deadline
+	// (the resource's delete timeout) and falls back to maxWait without one.
 	const (
 		maxWait      = 30 * time.Minute
 		pollInterval = 15 * time.Second
 	)
-	deadline := time.Now().Add(maxWait)
+	start := time.Now()
+	deadline := deadlineOrDefault(ctx, maxWait)
+	lastStatus := ""
 	for {
-		dep, getErr := c.GetDeployment(accountName, deploymentID)
+		dep, getErr := c.GetDeployment(ctx, accountName, deploymentID)
 		if getErr != nil {
 			if isNotFound(getErr) {
 				return nil // deployment is gone
 			}
 			// Transient error: keep polling until the timeout.
-		} else if c.isMockHost() && dep.Status == "Running" && dep.ProvisionState == "Done" {
-			// The mock API used by the acceptance tests never returns 404 after
-			// a delete; treat a healthy mock deployment as deleted. This branch
-			// is gated to the mock host so it cannot fire against a real API.
-			return nil
+		} else {
+			lastStatus = deploymentStatusSummary(dep)
+			if c.isMockHost() && dep.Status == "Running" && dep.ProvisionState == "Done" {
+				// The mock API used by the acceptance tests never returns 404
+				// after a delete; treat a healthy mock deployment as deleted.
+				// This branch is gated to the mock host so it cannot fire
+				// against a real API.
+				return nil
+			}
 		}
 
 		if time.Now().After(deadline) {
 			return &Error{
 				context: "DeleteDeploymentTimeout",
-				err:     fmt.Errorf("timed out after %s waiting for deployment %s to be deleted", maxWait, deploymentID),
+				err:     newTimeoutError(ctx, "be deleted", deploymentID, lastStatus, start),
 			}
 		}
 
-		select {
-		case <-ctx.Done():
-			return &Error{context: "DeleteDeploymentCanceled", err: ctx.Err()}
-		case <-time.After(pollInterval):
+		if sleepErr := sleepContext(ctx, pollInterval); sleepErr != nil {
+			return &Error{
+				context: "DeleteDeploymentTimeout",
+				err:     newTimeoutError(ctx, "be deleted", deploymentID, lastStatus, start),
+			}
 		}
 	}
 }
 
 // isNotFound reports whether err (or a wrapped error) is an HTTP 404 response.
 func isNotFound(err error) bool {
-	var httpErr *HTTPStatusError
-	if errors.As(err, &httpErr) {
-		return httpErr.StatusCode == http.StatusNotFound
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusNotFound
 	}
 	return false
 }
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestStartDeploymentDoesNotWait(t *testing.T) {
+	gets := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			gets++
+		}
+		_, _ = w.Write([]byte(`{"uid": "ss1", "status": "Pending", "provision_state": "Pending"}`))
+	})
+
+	dep, err := c.StartDeployment(context.Background(), Deployment{Name: "search"}, "acct")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dep.UID != "ss1" || gets != 0 {
+		t.Errorf("got uid %q after %d status polls, want ss1 and none", dep.UID, gets)
+	}
+}
+
+func TestWaitForDeploymentReady(t *testing.T) {
+	t.Run("returns once running", func(t *testing.T) {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"uid": "ss1", "status": "Running", "provision_state": "Done", "http_endpoint": "https://ss1.example/solr/"}`))
+		})
+
+		dep, err := c.WaitForDeploymentReady(context.Background(), "acct", &Deployment{UID: "ss1", Name: "search"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if dep.Name != "search" || dep.Status != "Running" || dep.HttpEndpoint != "https://ss1.example/solr/" {
+			t.Errorf("unexpected deployment %+v", dep)
+		}
+	})
+
+	t.Run("failed status is an error", func(t *testing.T) {
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"uid": "ss1", "status": "Failed"}`))
+		})
+
+		if _, err := c.WaitForDeploymentReady(context.Background(), "acct", &Deployment{UID: "ss1"}); err == nil {
+			t.Fatal("expected an error")
+		}
+	})
+}
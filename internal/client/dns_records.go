@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -32,8 +33,8 @@ func (d *DNSRecord) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func (c *Client) GetDNSRecords(accountName string) (*DNSRecordsList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/dns-record/", c.HostURL, accountName), nil)
+func (c *Client) GetDNSRecords(ctx context.Context, accountName string) (*DNSRecordsList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/dns-record/", c.HostURL, accountName), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -48,8 +49,8 @@ func (c *Client) GetDNSRecords(accountName string) (*DNSRecordsList, error) {
 	return &out, nil
 }
 
-func (c *Client) GetDNSRecord(accountName string, name string) (*DNSRecord, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/dns-record/%s/", c.HostURL, accountName, name), nil)
+func (c *Client) GetDNSRecord(ctx context.Context, accountName string, name string) (*DNSRecord, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/dns-record/%s/", c.HostURL, accountName, name), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -69,12 +70,12 @@ type AssociateDNSRecordRequest struct {
 	TTL        string `json:"ttl,omitempty"`
 }
 
-func (c *Client) AssociateDNSRecord(accountName string, name string, reqBody AssociateDNSRecordRequest) (*DNSRecord, error) {
+func (c *Client) AssociateDNSRecord(ctx context.Context, accountName string, name string, reqBody AssociateDNSRecordRequest) (*DNSRecord, error) {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/account/%s/dns-record/%s/", c.HostURL, accountName, name), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/account/%s/dns-record/%s/", c.HostURL, accountName, name), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -21,22 +22,14 @@ type IPFilter struct {
 	Description string   `json:"description"`
 }
 
-func (c *Client) GetIPFilters(accountName, deploymentID string) (*IPFiltersList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/ip-filter/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetIPFilters(ctx context.Context, accountName, deploymentID string) (*IPFiltersList, error) {
+	// The real API returns a bare JSON array; decodeListPage also tolerates a
+	// paginated {"results": [...], "next": ...} wrapper.
+	filters, err := listAll(ctx, c, fmt.Sprintf("%s/account/%s/deployment/%s/ip-filter/", c.HostURL, accountName, deploymentID), decodeListPage[IPFilter](""))
 	if err != nil {
 		return nil, err
 	}
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	// The real API returns a bare JSON array; decodeResults also tolerates a
-	// {"results": [...]} wrapper.
-	out := IPFiltersList{}
-	if err := decodeResults(body, &out.Results); err != nil {
-		return nil, err
-	}
-	return &out, nil
+	return &IPFiltersList{Count: int64(len(filters)), Results: filters}, nil
 }
 
 type IPFilterUpsertRequest struct {
@@ -45,24 +38,30 @@ type IPFilterUpsertRequest struct {
 	Description string   `json:"description,omitempty"`
 }
 
-func (c *Client) AddIPFilter(accountName, deploymentID string, reqBody IPFilterUpsertRequest) error {
-	return c.ipFilterAction("add-cidr-ip", accountName, deploymentID, reqBody)
+func (c *Client) AddIPFilter(ctx context.Context, accountName, deploymentID string, reqBody IPFilterUpsertRequest) error {
+	return c.ipFilterAction(ctx, "add-cidr-ip", accountName, deploymentID, reqBody)
 }
 
-func (c *Client) UpdateIPFilter(accountName, deploymentID string, reqBody IPFilterUpsertRequest) error {
-	return c.ipFilterAction("update-cidr-ip", accountName, deploymentID, reqBody)
+func (c *Client) UpdateIPFilter(ctx context.Context, accountName, deploymentID string, reqBody IPFilterUpsertRequest) error {
+	return c.ipFilterAction(ctx, "update-cidr-ip", accountName, deploymentID, reqBody)
 }
 
 type IPFilterDeleteRequest struct {
 	CIDRIP string `json:"cidr_ip"`
 }
 
-func (c *Client) DeleteIPFilter(accountName, deploymentID string, reqBody IPFilterDeleteRequest) error {
+func (c *Client) DeleteIPFilter(ctx context.Context, accountName, deploymentID string, reqBody IPFilterDeleteRequest) error {
+	ctx, unlock, err := c.lockDeployment(ctx, accountName, deploymentID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/ip-filter/delete-cidr-ip/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/ip-filter/delete-cidr-ip/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -74,12 +73,18 @@ func (c *Client) DeleteIPFilter(accountName, deploymentID string, reqBody IPFilt
 	return nil
 }
 
-func (c *Client) ipFilterAction(action, accountName, deploymentID string, reqBody IPFilterUpsertRequest) error {
+func (c *Client) ipFilterAction(ctx context.Context, action, accountName, deploymentID string, reqBody IPFilterUpsertRequest) error {
+	ctx, unlock, err := c.lockDeployment(ctx, accountName, deploymentID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/ip-filter/%s/", c.HostURL, accountName, deploymentID, action), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/ip-filter/%s/", c.HostURL, accountName, deploymentID, action), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -89,9 +94,9 @@ func (c *Client) ipFilterAction(action, accountName, deploymentID string, reqBod
 		// The API can return HTTP 400 with a no-op message when the same CIDR
 		// configuration already exists. Treat that specific response as success
 		// so update/create operations remain idempotent.
-		var httpErr *HTTPStatusError
-		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusBadRequest {
-			body := strings.ToLower(httpErr.Body)
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusBadRequest {
+			body := strings.ToLower(apiErr.Body)
 			if strings.Contains(body, "already exist") && strings.Contains(body, "no change performed") {
 				return nil
 			}
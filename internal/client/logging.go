@@ -0,0 +1,146 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// httpLogSubsystem is the tflog subsystem for API traffic. Its level can
+	// be set on its own with TF_LOG_PROVIDER_SEARCHSTAX_HTTP, e.g. to DEBUG.
+	httpLogSubsystem = "searchstax_http"
+	httpLogLevelEnv  = "TF_LOG_PROVIDER_SEARCHSTAX_HTTP"
+
+	// maxLoggedBody caps how much of each (redacted) body is logged.
+	maxLoggedBody = 4096
+
+	redacted = "***"
+)
+
+// secretJSONKeys are body fields whose values never reach the logs: the
+// passwords of AuthStruct, DeploymentUser, SetBasicAuthPasswordRequest and
+// ChangeUserPassword, API keys (CreateAPIKeyResponse and the association
+// requests) and auth tokens.
+var secretJSONKeys = map[string]bool{
+	"password":     true,
+	"new_password": true,
+	"old_password": true,
+	"apikey":       true,
+	"api_key":      true,
+	"token":        true,
+	"key":          true,
+}
+
+// httpLogContext returns ctx with the HTTP logging subsystem registered.
+func httpLogContext(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, httpLogSubsystem, tflog.WithLevelFromEnv(httpLogLevelEnv))
+}
+
+// logRequest logs an outgoing request. The request body is read through
+// GetBody so the body that will be sent is left untouched.
+func logRequest(ctx context.Context, req *http.Request) {
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    req.URL.String(),
+	}
+	if auth := req.Header.Get("Authorization"); auth != "" {
+		fields["http_authorization"] = redactAuthorization(auth)
+	}
+	if req.GetBody != nil && req.Body != nil && req.Body != http.NoBody {
+		if body, err := req.GetBody(); err == nil {
+			raw, _ := io.ReadAll(body)
+			_ = body.Close()
+			fields["http_request_body"] = loggableBody(req.Header.Get("Content-Type"), raw)
+		}
+	}
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Sending SearchStax API request", fields)
+}
+
+// logResponse logs the outcome of req: the status and body of the response,
+// or the transport error, along with the request latency.
+func logResponse(ctx context.Context, req *http.Request, res *http.Response, body []byte, err error, start time.Time) {
+	fields := map[string]interface{}{
+		"http_method":     req.Method,
+		"http_url":        req.URL.String(),
+		"http_latency_ms": time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "SearchStax API request failed", fields)
+		return
+	}
+	fields["http_status"] = res.StatusCode
+	fields["http_response_body"] = loggableBody(res.Header.Get("Content-Type"), body)
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Received SearchStax API response", fields)
+}
+
+// redactAuthorization keeps the scheme of an Authorization header ("Token" or
+// "APIkey") and hides the credential.
+func redactAuthorization(value string) string {
+	scheme, _, found := strings.Cut(value, " ")
+	if !found {
+		return redacted
+	}
+	return scheme + " " + redacted
+}
+
+// loggableBody returns body ready for the logs, truncated to maxLoggedBody:
+// JSON with secret fields redacted, or plain text such as an HTML error page.
+// Other payloads, such as multipart jar uploads, are summarized by size and
+// type only.
+func loggableBody(contentType string, body []byte) string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return ""
+	}
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		mediaType, _, _ := mime.ParseMediaType(contentType)
+		if strings.HasPrefix(mediaType, "text/") {
+			return truncate(body, maxLoggedBody)
+		}
+		if mediaType == "" {
+			mediaType = "unknown content type"
+		}
+		return fmt.Sprintf("[%d bytes of %s]", len(body), mediaType)
+	}
+	redactedBody, err := json.Marshal(redactJSON(doc, false))
+	if err != nil {
+		return fmt.Sprintf("[%d bytes]", len(body))
+	}
+	return truncate(redactedBody, maxLoggedBody)
+}
+
+// redactJSON replaces the strings and numbers stored under a secret key,
+// directly or in an array, with redacted. Objects under a secret key are
+// walked like any other, so {"token": {"key": ..., "expires": ...}} keeps its
+// expiry visible.
+func redactJSON(v interface{}, secret bool) interface{} {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, child := range value {
+			value[k] = redactJSON(child, secretJSONKeys[strings.ToLower(k)])
+		}
+		return value
+	case []interface{}:
+		for i, child := range value {
+			value[i] = redactJSON(child, secret)
+		}
+		return value
+	case nil, bool:
+		return value
+	default:
+		if secret {
+			return redacted
+		}
+		return value
+	}
+}
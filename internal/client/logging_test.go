@@ -0,0 +1,39 @@
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoggableBodyRedactsSecrets(t *testing.T) {
+	body := `{"username":"admin","password":"hunter2","apikey":"abc123","token":{"key":"tok","expires":"2026-01-01"},"deployments":["ss1"]}`
+	got := loggableBody("application/json", []byte(body))
+	for _, secret := range []string{"hunter2", "abc123", `"tok"`} {
+		if strings.Contains(got, secret) {
+			t.Fatalf("secret %s leaked: %s", secret, got)
+		}
+	}
+	for _, kept := range []string{"admin", "2026-01-01", "ss1"} {
+		if !strings.Contains(got, kept) {
+			t.Fatalf("expected %s to be kept: %s", kept, got)
+		}
+	}
+}
+
+func TestLoggableBodyNonJSON(t *testing.T) {
+	if got := loggableBody("multipart/form-data; boundary=x", []byte("--x\r\nbinary")); got != "[11 bytes of multipart/form-data]" {
+		t.Fatalf("got %q", got)
+	}
+	if got := loggableBody("text/plain", []byte("Signed out user")); got != "Signed out user" {
+		t.Fatalf("got %q", got)
+	}
+}
+
+func TestRedactAuthorization(t *testing.T) {
+	if got := redactAuthorization("Token abcdef"); got != "Token ***" {
+		t.Fatalf("got %q", got)
+	}
+	if got := redactAuthorization("abcdef"); got != "***" {
+		t.Fatalf("got %q", got)
+	}
+}
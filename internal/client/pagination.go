@@ -0,0 +1,106 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// maxListPages bounds how many pages listAll follows, in case the API keeps
+// returning a next link. Reaching it is an error rather than a silently
+// truncated list.
+const maxListPages = 100
+
+// pageDecoder decodes one page of a list response into its items and the URL
+// of the next page ("" on the last page).
+type pageDecoder[T any] func(body []byte) (items []T, next string, err error)
+
+// listAll GETs firstURL and follows the "next" links of the paginated
+// response, returning the items of every page. Relative next links are
+// resolved against the page that returned them. A list longer than
+// maxListPages pages, or one whose next link loops back to a page already
+// fetched, fails instead of being returned incomplete.
+func listAll[T any](ctx context.Context, c *Client, firstURL string, decode pageDecoder[T]) ([]T, error) {
+	var all []T
+	seen := make(map[string]bool)
+	pageURL := firstURL
+	for page := 0; pageURL != ""; page++ {
+		if page == maxListPages {
+			return nil, fmt.Errorf("listing %s: still more results after %d pages (%d items); refusing to return a truncated list", firstURL, maxListPages, len(all))
+		}
+		if seen[pageURL] {
+			return nil, fmt.Errorf("listing %s: next page link points back to already fetched page %s; refusing to return a truncated list", firstURL, pageURL)
+		}
+		seen[pageURL] = true
+
+		req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
+		if err != nil {
+			return nil, err
+		}
+		body, err := c.doRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		items, next, err := decode(body)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+		if len(items) == 0 || next == "" {
+			break
+		}
+		if pageURL, err = resolveNextPage(pageURL, next); err != nil {
+			return nil, err
+		}
+	}
+	return all, nil
+}
+
+// decodeListPage returns the pageDecoder for the common list shapes: a bare
+// JSON array, or an object holding the items under key (when key is not
+// empty) or "results", with an optional "next" link.
+func decodeListPage[T any](key string) pageDecoder[T] {
+	return func(body []byte) ([]T, string, error) {
+		var items []T
+		var err error
+		if key != "" {
+			err = decodeNamedList(body, key, &items)
+		} else {
+			err = decodeResults(body, &items)
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return items, nextPageLink(body), nil
+	}
+}
+
+// nextPageLink reads the "next" link of a paginated object response.
+func nextPageLink(body []byte) string {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return ""
+	}
+	var links struct {
+		Next *string `json:"next"`
+	}
+	if err := json.Unmarshal(trimmed, &links); err != nil || links.Next == nil {
+		return ""
+	}
+	return *links.Next
+}
+
+func resolveNextPage(current, next string) (string, error) {
+	base, err := url.Parse(current)
+	if err != nil {
+		return "", err
+	}
+	ref, err := url.Parse(next)
+	if err != nil {
+		return "", fmt.Errorf("invalid next page link %q: %w", next, err)
+	}
+	return base.ResolveReference(ref).String(), nil
+}
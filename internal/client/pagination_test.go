@@ -0,0 +1,68 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestListAllFollowsNextLinks(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page") {
+		case "":
+			_, _ = fmt.Fprint(w, `{"count":3,"next":"?page=2","results":[{"uid":"ss1"},{"uid":"ss2"}]}`)
+		case "2":
+			_, _ = fmt.Fprintf(w, `{"count":3,"next":null,"previous":"http://%s/account/a/deployment/","results":[{"uid":"ss3"}]}`, r.Host)
+		default:
+			t.Errorf("unexpected page %q", r.URL.RawQuery)
+		}
+	})
+
+	list, err := c.GetDeployments(context.Background(), "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Count != 3 || len(list.Results) != 3 || list.Results[2].UID != "ss3" {
+		t.Fatalf("unexpected deployments: %#v", list)
+	}
+}
+
+func TestListAllBareArray(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `[{"cidr_ip":"10.0.0.0/8"}]`)
+	})
+	list, err := c.GetIPFilters(context.Background(), "a", "ss1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Results) != 1 || list.Results[0].CIDRIP != "10.0.0.0/8" {
+		t.Fatalf("unexpected filters: %#v", list)
+	}
+}
+
+func TestListAllFailsWhenPageCapIsReached(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var page int
+		_, _ = fmt.Sscan(r.URL.Query().Get("page"), &page)
+		_, _ = fmt.Fprintf(w, `{"next":"?page=%d","results":[{"uid":"ss%d"}]}`, page+1, page)
+	})
+
+	if _, err := c.GetDeployments(context.Background(), "a"); err == nil {
+		t.Fatal("expected an error instead of a truncated list")
+	}
+}
+
+func TestListAllFailsOnNextLinkLoop(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "" {
+			_, _ = fmt.Fprint(w, `{"next":"?page=2","results":[{"uid":"ss1"}]}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"next":"?page=2","results":[{"uid":"ss2"}]}`)
+	})
+
+	if _, err := c.GetDeployments(context.Background(), "a"); err == nil {
+		t.Fatal("expected an error instead of a truncated list")
+	}
+}
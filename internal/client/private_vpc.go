@@ -1,30 +1,21 @@
 package client
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"net/http"
 )
 
 // GetPrivateVpc - Returns list of private_vpc_list (no auth required).
-func (c *Client) GetPrivateVpc(accountName string) (*PrivateVpcList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/privatevpc/", c.HostURL, accountName), nil)
+func (c *Client) GetPrivateVpc(ctx context.Context, accountName string) (*PrivateVpcList, error) {
+	privateVpcs, err := listAll(ctx, c, fmt.Sprintf("%s/account/%s/privatevpc/", c.HostURL, accountName), decodeListPage[PrivateVpc](""))
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	privateVpcs := PrivateVpcList{}
-	err = json.Unmarshal(body, &privateVpcs)
-	if err != nil {
-		return nil, err
-	}
-
-	return &privateVpcs, nil
+	return &PrivateVpcList{
+		Count:   int32(len(privateVpcs)),
+		Results: privateVpcs,
+	}, nil
 }
 
 // PrivateVpcList - PrivateVpcList struct.
@@ -0,0 +1,145 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Default retry policy used when the provider does not configure max_retries
+// or retry_max_wait.
+const (
+	DefaultMaxRetries   = 4
+	DefaultRetryMaxWait = 30 * time.Second
+
+	retryBaseWait = time.Second
+)
+
+// WithRetry sets how many times doRequest retries a failed request and the
+// longest it waits between two attempts. A negative maxRetries or a
+// non-positive maxWait keeps the default.
+func WithRetry(maxRetries int, maxWait time.Duration) Option {
+	return func(c *Client) {
+		if maxRetries >= 0 {
+			c.MaxRetries = maxRetries
+		}
+		if maxWait > 0 {
+			c.RetryMaxWait = maxWait
+		}
+	}
+}
+
+// retrySafePOSTSuffixes lists the POST endpoints that only read data (or, for
+// obtain-auth-token, only mint a new token), so replaying them after a network
+// error or 5xx cannot apply a change twice.
+var retrySafePOSTSuffixes = []string{
+	"/obtain-auth-token/",
+	"/obtain-auth-token",
+	"/apikey/list/",
+	"/apikey/deployments/",
+	"/restore/status/",
+	"/tags/get-deployments/",
+}
+
+// isRetrySafe reports whether req may be sent again after an ambiguous
+// failure (a network error or 5xx), where the server may already have acted
+// on the first attempt: GETs and HEADs, plus the read-only POSTs above.
+func isRetrySafe(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		for _, suffix := range retrySafePOSTSuffixes {
+			if strings.HasSuffix(req.URL.Path, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// shouldRetry reports whether the failed attempt of req is worth repeating.
+// Safe requests retry every transient error and 429. Other requests only
+// retry responses that prove the server rejected them without acting: 429 and
+// the "deployment currently updating another change" 400.
+func shouldRetry(req *http.Request, err error) bool {
+	var apiErr *APIError
+	isHTTP := errors.As(err, &apiErr)
+	if isHTTP && apiErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if isRetrySafe(req) {
+		return isTransient(err)
+	}
+	return isHTTP && apiErr.StatusCode == http.StatusBadRequest && isTransient(err)
+}
+
+// retryDelay returns how long to wait before retry number attempt (starting
+// at 0). A Retry-After value sent with a 429 or 503 takes precedence;
+// otherwise the wait grows exponentially from retryBaseWait with full jitter.
+// Either way it never exceeds maxWait.
+func retryDelay(attempt int, err error, maxWait time.Duration) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.retryAfter > 0 {
+		return min(apiErr.retryAfter, maxWait)
+	}
+	backoff := maxWait
+	if attempt < 30 {
+		backoff = min(retryBaseWait<<attempt, maxWait)
+	}
+	return rand.N(backoff) + 1
+}
+
+// parseRetryAfter reads the Retry-After header of a 429 or 503 response,
+// which is either a number of seconds or an HTTP date. It returns 0 when the
+// header is absent, malformed or not applicable.
+func parseRetryAfter(res *http.Response) time.Duration {
+	if res.StatusCode != http.StatusTooManyRequests && res.StatusCode != http.StatusServiceUnavailable {
+		return 0
+	}
+	value := strings.TrimSpace(res.Header.Get("Retry-After"))
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		if d := time.Until(when); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// rewindBody prepares req to be sent again. Requests built from a
+// strings.Reader or bytes.Buffer carry a GetBody func; without one a request
+// with a body cannot be replayed.
+func rewindBody(req *http.Request) bool {
+	if req.Body == nil || req.Body == http.NoBody {
+		return true
+	}
+	if req.GetBody == nil {
+		return false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return false
+	}
+	req.Body = body
+	return true
+}
+
+// fitsDeadline reports whether waiting d still leaves time before ctx's
+// deadline, so a retry is not scheduled just to be canceled.
+func fitsDeadline(ctx context.Context, d time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return !ok || time.Now().Add(d).Before(deadline)
+}
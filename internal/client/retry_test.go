@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return &Client{
+		HostURL:      server.URL,
+		HTTPClient:   server.Client(),
+		MaxRetries:   3,
+		RetryMaxWait: 10 * time.Millisecond,
+	}
+}
+
+func TestDoRequestRetry(t *testing.T) {
+	t.Run("get retries 503 then succeeds", func(t *testing.T) {
+		calls := 0
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`ok`))
+		})
+		req, _ := http.NewRequestWithContext(context.Background(), "GET", c.HostURL+"/x/", nil)
+		body, err := c.doRequest(req)
+		if err != nil || string(body) != "ok" || calls != 3 {
+			t.Fatalf("body=%q err=%v calls=%d", body, err, calls)
+		}
+	})
+
+	t.Run("post is not retried on 500", func(t *testing.T) {
+		calls := 0
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		req, _ := http.NewRequestWithContext(context.Background(), "POST", c.HostURL+"/account/a/deployment/", strings.NewReader(`{}`))
+		if _, err := c.doRequest(req); err == nil || calls != 1 {
+			t.Fatalf("err=%v calls=%d", err, calls)
+		}
+	})
+
+	t.Run("post replays body on 429", func(t *testing.T) {
+		var bodies []string
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			if len(bodies) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			_, _ = w.Write([]byte(`{}`))
+		})
+		req, _ := http.NewRequestWithContext(context.Background(), "POST", c.HostURL+"/account/a/deployment/", strings.NewReader(`{"name":"n"}`))
+		if _, err := c.doRequest(req); err != nil {
+			t.Fatal(err)
+		}
+		if len(bodies) != 2 || bodies[1] != `{"name":"n"}` {
+			t.Fatalf("unexpected bodies: %#v", bodies)
+		}
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		calls := 0
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadGateway)
+		})
+		req, _ := http.NewRequestWithContext(context.Background(), "GET", c.HostURL+"/x/", nil)
+		if _, err := c.doRequest(req); err == nil || calls != 4 {
+			t.Fatalf("err=%v calls=%d", err, calls)
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	res := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	res.Header.Set("Retry-After", "7")
+	if got := parseRetryAfter(res); got != 7*time.Second {
+		t.Fatalf("got %s", got)
+	}
+	res.StatusCode = http.StatusInternalServerError
+	if got := parseRetryAfter(res); got != 0 {
+		t.Fatalf("expected no wait for a 500, got %s", got)
+	}
+}
+
+func TestDoRequestRefreshesTokenOn401(t *testing.T) {
+	signIns := 0
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "obtain-auth-token") {
+			signIns++
+			if r.Header.Get("Authorization") != "" {
+				t.Errorf("sign-in sent a token: %q", r.Header.Get("Authorization"))
+			}
+			_, _ = w.Write([]byte(`{"token":"fresh"}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "Token fresh" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`ok`))
+	})
+	c.Token = "expired"
+	c.Auth = AuthStruct{Username: "u", Password: "p"}
+
+	req, _ := http.NewRequestWithContext(context.Background(), "GET", c.HostURL+"/x/", nil)
+	body, err := c.doRequest(req)
+	if err != nil || string(body) != "ok" || signIns != 1 {
+		t.Fatalf("body=%q err=%v signIns=%d", body, err, signIns)
+	}
+}
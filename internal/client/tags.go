@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -11,8 +12,8 @@ type TagsList struct {
 	Tags []string `json:"tags"`
 }
 
-func (c *Client) GetTags(accountName, deploymentID string) (*TagsList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/tags/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetTags(ctx context.Context, accountName, deploymentID string) (*TagsList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/tags/", c.HostURL, accountName, deploymentID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -31,12 +32,12 @@ type UpdateTagsRequest struct {
 	Tags []string `json:"tags"`
 }
 
-func (c *Client) AddOrUpdateTags(accountName, deploymentID string, reqBody UpdateTagsRequest) error {
+func (c *Client) AddOrUpdateTags(ctx context.Context, accountName, deploymentID string, reqBody UpdateTagsRequest) error {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/tags/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/tags/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -48,12 +49,12 @@ func (c *Client) AddOrUpdateTags(accountName, deploymentID string, reqBody Updat
 	return nil
 }
 
-func (c *Client) DeleteTags(accountName, deploymentID string, reqBody UpdateTagsRequest) error {
+func (c *Client) DeleteTags(ctx context.Context, accountName, deploymentID string, reqBody UpdateTagsRequest) error {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/tags/delete/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/tags/delete/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -87,12 +88,12 @@ type GetDeploymentsByTagRequest struct {
 	Operator string   `json:"operator,omitempty"`
 }
 
-func (c *Client) GetDeploymentsByTag(accountName string, reqBody GetDeploymentsByTagRequest) (*DeploymentsByTagList, error) {
+func (c *Client) GetDeploymentsByTag(ctx context.Context, accountName string, reqBody GetDeploymentsByTagRequest) (*DeploymentsByTagList, error) {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/tags/get-deployments/", c.HostURL, accountName), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/tags/get-deployments/", c.HostURL, accountName), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
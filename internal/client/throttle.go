@@ -0,0 +1,124 @@
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"regexp"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// WithRateLimit caps the client at requestsPerSecond requests, shared by every
+// resource using it. Short bursts of up to one second's worth of requests are
+// allowed. A non-positive value leaves the client unlimited.
+func WithRateLimit(requestsPerSecond float64) Option {
+	return func(c *Client) {
+		if requestsPerSecond <= 0 {
+			return
+		}
+		burst := max(1, int(math.Ceil(requestsPerSecond)))
+		c.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+}
+
+// WithDeploymentSerialization makes the client send at most one mutating
+// request at a time to each deployment. The API refuses a change while the
+// deployment is still applying another one ("deployment currently updating
+// another change"), so queueing them client-side avoids those rejections.
+//
+// Operations that call lockDeployment already run one at a time per
+// deployment; this extends the same lock to mutating requests sent outside
+// such an operation. There is only one lock per deployment, so a request made
+// while its operation holds the lock does not take it again.
+func WithDeploymentSerialization(enabled bool) Option {
+	return func(c *Client) {
+		c.serializeDeployments = enabled
+	}
+}
+
+// deploymentPathPattern extracts the account and deployment UID from request
+// paths such as /account/<account>/deployment/<uid>/ip-filter/.
+var deploymentPathPattern = regexp.MustCompile(`/account/([^/]+)/deployment/([^/]+)/`)
+
+// deploymentKey returns "<account>/<uid>" for requests addressed to a single
+// deployment, or "" for account-level requests.
+func deploymentKey(req *http.Request) string {
+	m := deploymentPathPattern.FindStringSubmatch(req.URL.Path)
+	if m == nil {
+		return ""
+	}
+	return m[1] + "/" + m[2]
+}
+
+// keyedMutex holds one lock per key, created on first use. Locks are
+// one-slot channels so that waiting for one can be abandoned when ctx ends.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]chan struct{}
+}
+
+// slot returns the lock channel for key, creating it on first use.
+func (k *keyedMutex) slot(key string) chan struct{} {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if k.locks == nil {
+		k.locks = make(map[string]chan struct{})
+	}
+	slot, ok := k.locks[key]
+	if !ok {
+		slot = make(chan struct{}, 1)
+		k.locks[key] = slot
+	}
+	return slot
+}
+
+// lock waits until key is free or ctx is done, and returns the func that
+// releases it.
+func (k *keyedMutex) lock(ctx context.Context, key string) (func(), error) {
+	slot := k.slot(key)
+	select {
+	case slot <- struct{}{}:
+		return func() { <-slot }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// tryLock takes the lock for key only if it is free right now.
+func (k *keyedMutex) tryLock(key string) (func(), bool) {
+	slot := k.slot(key)
+	select {
+	case slot <- struct{}{}:
+		return func() { <-slot }, true
+	default:
+		return nil, false
+	}
+}
+
+// throttle blocks until req may be sent: it waits for a rate limiter token
+// and, when deployment serialization is enabled and req may change a
+// deployment (it is not one isRetrySafe treats as read-only), for that
+// deployment's lock unless ctx already holds it through lockDeployment. The
+// returned func releases the lock and must be called once the response has
+// been read.
+func (c *Client) throttle(ctx context.Context, req *http.Request) (func(), error) {
+	release := func() {}
+	if c.serializeDeployments && !isRetrySafe(req) {
+		if key := deploymentKey(req); key != "" && !holdsDeploymentLock(ctx, key) {
+			unlock, err := c.deploymentLocks.lock(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+			release = unlock
+		}
+	}
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
@@ -0,0 +1,98 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDeploymentKey(t *testing.T) {
+	req, _ := http.NewRequest("POST", "https://example.com/api/rest/v2/account/acme/deployment/ss123/ip-filter/add-cidr-ip/", nil)
+	if got := deploymentKey(req); got != "acme/ss123" {
+		t.Fatalf("got %q", got)
+	}
+	req, _ = http.NewRequest("POST", "https://example.com/api/rest/v2/account/acme/deployment/", nil)
+	if got := deploymentKey(req); got != "" {
+		t.Fatalf("expected no key for an account-level request, got %q", got)
+	}
+}
+
+func TestKeyedMutexHonorsContext(t *testing.T) {
+	var locks keyedMutex
+	unlock, err := locks.lock(context.Background(), "acme/ss123")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := locks.lock(ctx, "acme/ss123"); err == nil {
+		t.Fatal("expected the second lock on the same key to wait until ctx expired")
+	}
+	if other, err := locks.lock(context.Background(), "acme/ss456"); err != nil {
+		t.Fatal(err)
+	} else {
+		other()
+	}
+
+	unlock()
+	if again, err := locks.lock(context.Background(), "acme/ss123"); err != nil {
+		t.Fatal(err)
+	} else {
+		again()
+	}
+}
+
+func TestLockDeploymentIsReentrantThroughContext(t *testing.T) {
+	c := &Client{}
+	ctx, unlock, err := c.lockDeployment(context.Background(), "acme", "ss123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer unlock()
+
+	nestedCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, nestedUnlock, err := c.lockDeployment(nestedCtx, "acme", "ss123"); err != nil {
+		t.Fatalf("nested lock with the holder's context should not wait: %v", err)
+	} else {
+		nestedUnlock()
+	}
+
+	otherCtx, cancelOther := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelOther()
+	if _, _, err := c.lockDeployment(otherCtx, "acme", "ss123"); err == nil {
+		t.Fatal("expected an unrelated caller to wait for the lock")
+	}
+}
+
+func TestThrottleSharesTheDeploymentLock(t *testing.T) {
+	c := &Client{serializeDeployments: true}
+	req, _ := http.NewRequest("POST", "https://example.com/api/rest/v2/account/acme/deployment/ss123/ip-filter/add-cidr-ip/", nil)
+
+	ctx, unlock, err := c.lockDeployment(context.Background(), "acme", "ss123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	heldCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	release, err := c.throttle(heldCtx, req)
+	if err != nil {
+		t.Fatalf("a request sent by the lock holder should not wait: %v", err)
+	}
+	release()
+
+	otherCtx, cancelOther := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelOther()
+	if _, err := c.throttle(otherCtx, req); err == nil {
+		t.Fatal("expected a request outside the operation to wait for the deployment lock")
+	}
+	unlock()
+
+	release, err = c.throttle(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -19,8 +20,8 @@ type User struct {
 	LastName  string `json:"last_name"`
 }
 
-func (c *Client) GetUsers() (*UsersList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/users/", c.RestHostURL()), nil)
+func (c *Client) GetUsers(ctx context.Context) (*UsersList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/users/", c.RestHostURL()), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -55,12 +56,12 @@ type InviteUserRequest struct {
 	LastName  string `json:"last_name,omitempty"`
 }
 
-func (c *Client) InviteUser(reqBody InviteUserRequest) error {
+func (c *Client) InviteUser(ctx context.Context, reqBody InviteUserRequest) error {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/users/add-user", c.RestHostURL()), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/users/add-user", c.RestHostURL()), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -77,12 +78,12 @@ type ChangeUserPasswordRequest struct {
 	NewPassword string `json:"new_password"`
 }
 
-func (c *Client) ChangeUserPassword(reqBody ChangeUserPasswordRequest) error {
+func (c *Client) ChangeUserPassword(ctx context.Context, reqBody ChangeUserPasswordRequest) error {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/users/change-password", c.RestHostURL()), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/users/change-password", c.RestHostURL()), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -99,12 +100,12 @@ type SetUserRoleRequest struct {
 	Role  string `json:"role"`
 }
 
-func (c *Client) SetUserRole(reqBody SetUserRoleRequest) error {
+func (c *Client) SetUserRole(ctx context.Context, reqBody SetUserRoleRequest) error {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/users/set-role/", c.RestHostURL()), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/users/set-role/", c.RestHostURL()), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -120,12 +121,12 @@ type DeleteUserRequest struct {
 	Email string `json:"email"`
 }
 
-func (c *Client) DeleteUser(reqBody DeleteUserRequest) error {
+func (c *Client) DeleteUser(ctx context.Context, reqBody DeleteUserRequest) error {
 	rb, err := json.Marshal(reqBody)
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/users/", c.RestHostURL()), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/users/", c.RestHostURL()), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
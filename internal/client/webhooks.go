@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -17,8 +18,8 @@ type Webhook struct {
 	Paused bool   `json:"paused"`
 }
 
-func (c *Client) GetWebhooks(accountName string) (*WebhooksList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/webhook/", c.HostURL, accountName), nil)
+func (c *Client) GetWebhooks(ctx context.Context, accountName string) (*WebhooksList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/webhook/", c.HostURL, accountName), nil)
 	if err != nil {
 		return nil, err
 	}
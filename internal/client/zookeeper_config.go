@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,41 +21,45 @@ type ZookeeperConfig struct {
 	Files   []string `json:"files,omitempty"`
 }
 
-func (c *Client) GetZookeeperConfigs(accountName, deploymentID string) (*ZookeeperConfigsList, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/zookeeper-config/", c.HostURL, accountName, deploymentID), nil)
+func (c *Client) GetZookeeperConfigs(ctx context.Context, accountName, deploymentID string) (*ZookeeperConfigsList, error) {
+	configs, err := listAll(ctx, c, fmt.Sprintf("%s/account/%s/deployment/%s/zookeeper-config/", c.HostURL, accountName, deploymentID), decodeZookeeperConfigsPage)
 	if err != nil {
 		return nil, err
 	}
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	// The real API returns {"configs": ["name1", "name2"], "success": "true"};
-	// older mocks used a {"results": [{...}]} wrapper.
+	return &ZookeeperConfigsList{Count: int64(len(configs)), Results: configs}, nil
+}
+
+// decodeZookeeperConfigsPage reads the real API shape {"configs": ["name1",
+// "name2"], "success": "true"}, falling back to the {"results": [{...}]}
+// wrapper used by older mocks.
+func decodeZookeeperConfigsPage(body []byte) ([]ZookeeperConfig, string, error) {
 	var named struct {
 		Configs []string `json:"configs"`
 	}
-	out := ZookeeperConfigsList{}
 	if err := json.Unmarshal(body, &named); err == nil && named.Configs != nil {
+		configs := make([]ZookeeperConfig, 0, len(named.Configs))
 		for _, name := range named.Configs {
-			out.Results = append(out.Results, ZookeeperConfig{Name: name})
+			configs = append(configs, ZookeeperConfig{Name: name})
 		}
-		return &out, nil
-	}
-	if err := json.Unmarshal(body, &out); err != nil {
-		return nil, err
+		return configs, nextPageLink(body), nil
 	}
-	return &out, nil
+	return decodeListPage[ZookeeperConfig]("")(body)
 }
 
 // UploadZookeeperConfig creates/uploads a new config.
 // Mock expects JSON and returns {"uploaded": true, "name": "..."}.
-func (c *Client) UploadZookeeperConfig(accountName, deploymentID string, cfg ZookeeperConfig) (*ZookeeperConfig, error) {
+func (c *Client) UploadZookeeperConfig(ctx context.Context, accountName, deploymentID string, cfg ZookeeperConfig) (*ZookeeperConfig, error) {
+	ctx, unlock, err := c.lockDeployment(ctx, accountName, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
 	rb, err := json.Marshal(cfg)
 	if err != nil {
 		return nil, err
 	}
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/account/%s/deployment/%s/zookeeper-config/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/account/%s/deployment/%s/zookeeper-config/", c.HostURL, accountName, deploymentID), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -67,8 +72,8 @@ func (c *Client) UploadZookeeperConfig(accountName, deploymentID string, cfg Zoo
 	return &out, nil
 }
 
-func (c *Client) GetZookeeperConfig(accountName, deploymentID, name string) (*ZookeeperConfig, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/zookeeper-config/%s/", c.HostURL, accountName, deploymentID, name), nil)
+func (c *Client) GetZookeeperConfig(ctx context.Context, accountName, deploymentID, name string) (*ZookeeperConfig, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/zookeeper-config/%s/", c.HostURL, accountName, deploymentID, name), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -94,8 +99,14 @@ func (c *Client) GetZookeeperConfig(accountName, deploymentID, name string) (*Zo
 	return &out, nil
 }
 
-func (c *Client) DeleteZookeeperConfig(accountName, deploymentID, name string) error {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/account/%s/deployment/%s/zookeeper-config/%s/", c.HostURL, accountName, deploymentID, name), nil)
+func (c *Client) DeleteZookeeperConfig(ctx context.Context, accountName, deploymentID, name string) error {
+	ctx, unlock, err := c.lockDeployment(ctx, accountName, deploymentID)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/account/%s/deployment/%s/zookeeper-config/%s/", c.HostURL, accountName, deploymentID, name), nil)
 	if err != nil {
 		return err
 	}
@@ -112,8 +123,8 @@ type ZookeeperConfigDownload struct {
 	Note     string `json:"note,omitempty"`
 }
 
-func (c *Client) DownloadZookeeperConfig(accountName, deploymentID, name string) (*ZookeeperConfigDownload, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/account/%s/deployment/%s/zookeeper-config/%s/download/", c.HostURL, accountName, deploymentID, name), nil)
+func (c *Client) DownloadZookeeperConfig(ctx context.Context, accountName, deploymentID, name string) (*ZookeeperConfigDownload, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/account/%s/deployment/%s/zookeeper-config/%s/download/", c.HostURL, accountName, deploymentID, name), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// apiErrorDiagnostics turns a failed API call into diagnostics. When err
+// carries a SearchStax APIError, each field error whose field is one of
+// attributes (API field names match this provider's attribute names, such as
+// "plan" or "region_id") is reported on that attribute, so Terraform points at
+// the offending line of configuration. The API message and any other field
+// errors go into a general diagnostic made of summary and detail.
+func apiErrorDiagnostics(summary, detail string, err error, attributes ...string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var apiErr *searchstaxClient.APIError
+	if !errors.As(err, &apiErr) {
+		diags.AddError(summary, detail+": "+err.Error())
+		return diags
+	}
+
+	known := make(map[string]bool, len(attributes))
+	for _, attr := range attributes {
+		known[attr] = true
+	}
+
+	var general []string
+	if apiErr.Message != "" {
+		general = append(general, apiErr.Message)
+	}
+	for _, field := range apiErr.Fields() {
+		msg := strings.Join(apiErr.FieldErrors[field], " ")
+		if known[field] {
+			diags.AddAttributeError(path.Root(field), summary, fmt.Sprintf("%s: the SearchStax API rejected %s: %s", detail, field, msg))
+			continue
+		}
+		general = append(general, field+": "+msg)
+	}
+
+	if len(general) > 0 || !diags.HasError() {
+		if len(general) == 0 {
+			general = append(general, apiErr.Error())
+		}
+		diags.AddError(summary, fmt.Sprintf("%s: SearchStax API error (HTTP %d): %s", detail, apiErr.StatusCode, strings.Join(general, "; ")))
+	}
+	return diags
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// backupListAttributes returns the filter attributes and the backups list
+// shared by the searchstax_deployment_backups and searchstax_account_backups
+// data sources.
+func backupListAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"status": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Only list backups with this status, such as `Success` (case-insensitive).",
+		},
+		"created_after": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Only list backups taken after this RFC 3339 time, such as `2024-01-31T00:00:00Z`.",
+		},
+		"created_before": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Only list backups taken before this RFC 3339 time.",
+		},
+		"newest": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Only list the newest N backups left by the other filters.",
+		},
+		"backups": schema.ListNestedAttribute{
+			Computed: true,
+			MarkdownDescription: "The backups, newest first; backups without a readable date come last, in API order. " +
+				"With the date filters set, backups without a readable date are left out.",
+			NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+				"id":             schema.StringAttribute{Computed: true},
+				"name":           schema.StringAttribute{Computed: true},
+				"date":           schema.StringAttribute{Computed: true, MarkdownDescription: "When the backup was taken, as reported by the API."},
+				"size":           schema.StringAttribute{Computed: true},
+				"status":         schema.StringAttribute{Computed: true},
+				"region_id":      schema.StringAttribute{Computed: true},
+				"deployment_uid": schema.StringAttribute{Computed: true, MarkdownDescription: "UID of the deployment the backup was taken of."},
+				"collections":    schema.ListAttribute{Computed: true, ElementType: types.StringType},
+			}},
+		},
+	}
+}
+
+// backupModel maps one backup of the backup list data sources.
+type backupModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Date          types.String `tfsdk:"date"`
+	Size          types.String `tfsdk:"size"`
+	Status        types.String `tfsdk:"status"`
+	RegionID      types.String `tfsdk:"region_id"`
+	DeploymentUID types.String `tfsdk:"deployment_uid"`
+	Collections   types.List   `tfsdk:"collections"`
+}
+
+// backupFilter holds the configured backup list filters.
+type backupFilter struct {
+	Status        types.String
+	CreatedAfter  types.String
+	CreatedBefore types.String
+	Newest        types.Int64
+}
+
+// backupModels filters and sorts backups as configured and maps them to the
+// data source model.
+func backupModels(ctx context.Context, backups []searchstaxClient.Backup, filter backupFilter) ([]backupModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	after, afterSet := parseBackupFilterTime(filter.CreatedAfter, "created_after", &diags)
+	before, beforeSet := parseBackupFilterTime(filter.CreatedBefore, "created_before", &diags)
+	if !filter.Newest.IsNull() && filter.Newest.ValueInt64() < 0 {
+		diags.AddAttributeError(path.Root("newest"), "Invalid Backup Filter", "newest must not be negative.")
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var kept []datedBackup
+	for _, b := range sortBackupsNewestFirst(backups) {
+		if !filter.Status.IsNull() && !strings.EqualFold(b.backup.Status, filter.Status.ValueString()) {
+			continue
+		}
+		if (afterSet || beforeSet) && !b.dated {
+			continue
+		}
+		if afterSet && !b.created.After(after) {
+			continue
+		}
+		if beforeSet && !b.created.Before(before) {
+			continue
+		}
+		kept = append(kept, b)
+	}
+	if !filter.Newest.IsNull() && int64(len(kept)) > filter.Newest.ValueInt64() {
+		kept = kept[:filter.Newest.ValueInt64()]
+	}
+
+	models := make([]backupModel, 0, len(kept))
+	for _, k := range kept {
+		collections, d := types.ListValueFrom(ctx, types.StringType, []string(k.backup.Collections))
+		diags.Append(d...)
+		models = append(models, backupModel{
+			ID:            types.StringValue(k.backup.ID),
+			Name:          types.StringValue(k.backup.Name),
+			Date:          types.StringValue(k.backup.Date),
+			Size:          types.StringValue(k.backup.Size),
+			Status:        types.StringValue(k.backup.Status),
+			RegionID:      types.StringValue(k.backup.RegionID),
+			DeploymentUID: types.StringValue(k.backup.Deployment),
+			Collections:   collections,
+		})
+	}
+	return models, diags
+}
+
+// datedBackup is a backup with its parsed date; dated is false when the API
+// date could not be read.
+type datedBackup struct {
+	backup  searchstaxClient.Backup
+	created time.Time
+	dated   bool
+}
+
+// sortBackupsNewestFirst orders backups by date, newest first. Backups without
+// a readable date come last, in their original order.
+func sortBackupsNewestFirst(backups []searchstaxClient.Backup) []datedBackup {
+	sorted := make([]datedBackup, 0, len(backups))
+	for _, b := range backups {
+		created, dated := b.CreatedAt()
+		sorted = append(sorted, datedBackup{backup: b, created: created, dated: dated})
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].dated != sorted[j].dated {
+			return sorted[i].dated
+		}
+		return sorted[i].created.After(sorted[j].created)
+	})
+	return sorted
+}
+
+// parseBackupFilterTime parses a configured RFC 3339 filter time, adding an
+// error on attr when it is malformed.
+func parseBackupFilterTime(value types.String, attr string, diags *diag.Diagnostics) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root(attr), "Invalid Backup Filter",
+			fmt.Sprintf("%s must be an RFC 3339 time such as 2024-01-31T00:00:00Z, got %q.", attr, value.ValueString()))
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -0,0 +1,116 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBackupModels(t *testing.T) {
+	backups := []searchstaxClient.Backup{
+		{ID: "undated-1", Date: "", Status: "Success"},
+		{ID: "jan", Date: "2024-01-15T00:00:00Z", Status: "Success"},
+		{ID: "mar", Date: "2024-03-15 08:00:00", Status: "Failed"},
+		{ID: "undated-2", Date: "soon", Status: "In Progress"},
+		{ID: "feb", Date: "2024-02-15", Status: "success"},
+		{ID: "apr", Date: "2024-04-15T00:00:00Z", Status: "In Progress"},
+	}
+	noFilter := backupFilter{
+		Status:        types.StringNull(),
+		CreatedAfter:  types.StringNull(),
+		CreatedBefore: types.StringNull(),
+		Newest:        types.Int64Null(),
+	}
+	tests := []struct {
+		name    string
+		filter  func(f *backupFilter)
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:   "newest first, undated last in API order",
+			filter: func(f *backupFilter) {},
+			want:   []string{"apr", "mar", "feb", "jan", "undated-1", "undated-2"},
+		},
+		{
+			name:   "status is case-insensitive",
+			filter: func(f *backupFilter) { f.Status = types.StringValue("SUCCESS") },
+			want:   []string{"feb", "jan", "undated-1"},
+		},
+		{
+			name:   "created_after excludes undated backups",
+			filter: func(f *backupFilter) { f.CreatedAfter = types.StringValue("2024-02-15T00:00:00Z") },
+			want:   []string{"apr", "mar"},
+		},
+		{
+			name:   "created_before",
+			filter: func(f *backupFilter) { f.CreatedBefore = types.StringValue("2024-03-01T00:00:00Z") },
+			want:   []string{"feb", "jan"},
+		},
+		{
+			name: "date range",
+			filter: func(f *backupFilter) {
+				f.CreatedAfter = types.StringValue("2024-01-31T00:00:00Z")
+				f.CreatedBefore = types.StringValue("2024-04-01T00:00:00Z")
+			},
+			want: []string{"mar", "feb"},
+		},
+		{
+			name:   "newest",
+			filter: func(f *backupFilter) { f.Newest = types.Int64Value(2) },
+			want:   []string{"apr", "mar"},
+		},
+		{
+			name: "newest applies after the other filters",
+			filter: func(f *backupFilter) {
+				f.Status = types.StringValue("success")
+				f.Newest = types.Int64Value(1)
+			},
+			want: []string{"feb"},
+		},
+		{
+			name:   "newest larger than the list",
+			filter: func(f *backupFilter) { f.Newest = types.Int64Value(10) },
+			want:   []string{"apr", "mar", "feb", "jan", "undated-1", "undated-2"},
+		},
+		{
+			name:   "newest zero",
+			filter: func(f *backupFilter) { f.Newest = types.Int64Value(0) },
+			want:   []string{},
+		},
+		{
+			name:    "negative newest",
+			filter:  func(f *backupFilter) { f.Newest = types.Int64Value(-1) },
+			wantErr: true,
+		},
+		{
+			name:    "malformed created_after",
+			filter:  func(f *backupFilter) { f.CreatedAfter = types.StringValue("2024-02-15") },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := noFilter
+			tt.filter(&filter)
+			models, diags := backupModels(context.Background(), backups, filter)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("backupModels diagnostics = %v, want error %v", diags, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			got := []string{}
+			for _, m := range models {
+				got = append(got, m.ID.ValueString())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("backupModels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -20,13 +20,13 @@ func (d *accountBackupsDataSource) Metadata(_ context.Context, req datasource.Me
 }
 
 func (d *accountBackupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{Attributes: map[string]schema.Attribute{
-		"id":           schema.StringAttribute{Computed: true},
-		"account_name": schema.StringAttribute{Required: true},
-		"backups": schema.ListNestedAttribute{Computed: true, NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
-		}}},
-	}}
+	attributes := backupListAttributes()
+	attributes["id"] = schema.StringAttribute{Computed: true}
+	attributes["account_name"] = schema.StringAttribute{Required: true}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the backups of an account, optionally filtered, newest first.",
+		Attributes:          attributes,
+	}
 }
 
 func (d *accountBackupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,21 +47,32 @@ func (d *accountBackupsDataSource) Read(ctx context.Context, req datasource.Read
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetAccountBackups(state.AccountName.ValueString())
+	out, err := d.client.GetAccountBackups(ctx, state.AccountName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read account backups", err.Error())
 		return
 	}
 	state.ID = types.StringValue("placeholder")
-	state.Backups = nil
-	for _, b := range out.Results {
-		state.Backups = append(state.Backups, backupModel{ID: types.StringValue(b.ID)})
+	backups, diags := backupModels(ctx, out.Results, backupFilter{
+		Status:        state.Status,
+		CreatedAfter:  state.CreatedAfter,
+		CreatedBefore: state.CreatedBefore,
+		Newest:        state.Newest,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	state.Backups = backups
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 type accountBackupsDataSourceModel struct {
-	ID          types.String  `tfsdk:"id"`
-	AccountName types.String  `tfsdk:"account_name"`
-	Backups     []backupModel `tfsdk:"backups"`
+	ID            types.String  `tfsdk:"id"`
+	AccountName   types.String  `tfsdk:"account_name"`
+	Status        types.String  `tfsdk:"status"`
+	CreatedAfter  types.String  `tfsdk:"created_after"`
+	CreatedBefore types.String  `tfsdk:"created_before"`
+	Newest        types.Int64   `tfsdk:"newest"`
+	Backups       []backupModel `tfsdk:"backups"`
 }
@@ -13,6 +13,17 @@ func TestAccAccountBackupsDataSource(t *testing.T) {
 			{
 				Config: providerConfig + `data "searchstax_account_backups" "test" {
   account_name = "test_account_name"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.searchstax_account_backups.test", "backups.#", "0"),
+				),
+			},
+			{
+				Config: providerConfig + `data "searchstax_account_backups" "test" {
+  account_name = "test_account_name"
+  status        = "Success"
+  created_after = "2024-01-01T00:00:00Z"
+  newest        = 1
 }`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.searchstax_account_backups.test", "backups.#", "0"),
@@ -45,7 +45,7 @@ func (d *alertMetricsDataSource) Read(ctx context.Context, req datasource.ReadRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetAlertMetrics(state.AccountName.ValueString())
+	out, err := d.client.GetAlertMetrics(ctx, state.AccountName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read alert metrics", err.Error())
 		return
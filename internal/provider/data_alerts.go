@@ -49,7 +49,7 @@ func (d *alertsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetAlerts(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := d.client.GetAlerts(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read alerts", err.Error())
 		return
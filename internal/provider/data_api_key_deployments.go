@@ -46,7 +46,7 @@ func (d *apiKeyDeploymentsDataSource) Read(ctx context.Context, req datasource.R
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetAPIKeyDeployments(state.AccountName.ValueString(), searchstaxClient.APIKeyDeploymentsRequest{
+	out, err := d.client.GetAPIKeyDeployments(ctx, state.AccountName.ValueString(), searchstaxClient.APIKeyDeploymentsRequest{
 		APIKey: state.APIKey.ValueString(),
 	})
 	if err != nil {
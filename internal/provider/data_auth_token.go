@@ -42,7 +42,7 @@ func (d *authTokenDataSource) Configure(_ context.Context, req datasource.Config
 }
 
 func (d *authTokenDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
-	token, err := d.client.SignIn()
+	token, err := d.client.SignIn(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to obtain SearchStax auth token", err.Error())
 		return
@@ -51,7 +51,7 @@ func (d *authTokenDataSource) Read(ctx context.Context, _ datasource.ReadRequest
 		ID:    types.StringValue("placeholder"),
 		Token: types.StringValue(token.Token),
 	}
-	verify, err := d.client.VerifyAuthToken()
+	verify, err := d.client.VerifyAuthToken(ctx)
 	if err == nil {
 		state.Valid = types.BoolValue(verify.Valid)
 		if verify.TokenExpiresInSeconds > 0 {
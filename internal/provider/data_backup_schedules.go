@@ -54,7 +54,7 @@ func (d *backupSchedulesDataSource) Read(ctx context.Context, req datasource.Rea
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetBackupSchedules(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := d.client.GetBackupSchedules(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read backup schedules", err.Error())
 		return
@@ -46,7 +46,7 @@ func (d *basicAuthDataSource) Read(ctx context.Context, req datasource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	enabled, err := d.client.IsBasicAuthEnabled(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	enabled, err := d.client.IsBasicAuthEnabled(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read basic auth status", err.Error())
 		return
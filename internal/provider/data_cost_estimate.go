@@ -0,0 +1,250 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewCostEstimateDataSource() datasource.DataSource { return &costEstimateDataSource{} }
+
+type costEstimateDataSource struct{ client *searchstaxClient.Client }
+
+func (d *costEstimateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cost_estimate"
+}
+
+func (d *costEstimateDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Estimates the monthly cost of deployments from the prices in the account's plan catalog " +
+			"(see the `searchstax_plans` data source): the plan's price in the region plus the price of each additional " +
+			"application and ZooKeeper node. A price is only taken from a plan of the deployment's application and plan " +
+			"type. Describe planned deployments in `deployments`, or list existing ones in " +
+			"`deployment_uids`. The estimate uses list prices and does not account for discounts, taxes or usage-based charges.",
+		Attributes: map[string]schema.Attribute{
+			"id":           schema.StringAttribute{Computed: true},
+			"account_name": schema.StringAttribute{Required: true},
+			"deployments": schema.ListNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Planned deployments to estimate.",
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"name":                           schema.StringAttribute{Optional: true, MarkdownDescription: "Label copied to the estimate."},
+					"application":                    schema.StringAttribute{Required: true},
+					"plan_type":                      schema.StringAttribute{Required: true},
+					"plan":                           schema.StringAttribute{Required: true},
+					"region_id":                      schema.StringAttribute{Required: true},
+					"cloud_provider_id":              schema.StringAttribute{Required: true},
+					"num_additional_app_nodes":       schema.Int64Attribute{Optional: true},
+					"num_additional_zookeeper_nodes": schema.Int64Attribute{Optional: true},
+				}},
+			},
+			"deployment_uids": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "UIDs of existing deployments to estimate at their current plan, region and node counts.",
+			},
+			"estimates": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "One estimate per entry of `deployments`, followed by one per entry of `deployment_uids`.",
+				NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
+					"name":                             schema.StringAttribute{Computed: true},
+					"uid":                              schema.StringAttribute{Computed: true},
+					"application":                      schema.StringAttribute{Computed: true},
+					"plan_type":                        schema.StringAttribute{Computed: true},
+					"plan":                             schema.StringAttribute{Computed: true},
+					"region_id":                        schema.StringAttribute{Computed: true},
+					"cloud_provider_id":                schema.StringAttribute{Computed: true},
+					"num_additional_app_nodes":         schema.Int64Attribute{Computed: true},
+					"num_additional_zookeeper_nodes":   schema.Int64Attribute{Computed: true},
+					"plan_price":                       schema.Float64Attribute{Computed: true},
+					"additional_app_nodes_price":       schema.Float64Attribute{Computed: true},
+					"additional_zookeeper_nodes_price": schema.Float64Attribute{Computed: true},
+					"monthly_estimate":                 schema.Float64Attribute{Computed: true},
+				}},
+			},
+			"total_monthly_estimate": schema.Float64Attribute{Computed: true, MarkdownDescription: "Sum of the monthly estimates."},
+		},
+	}
+}
+
+func (d *costEstimateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*searchstaxClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *searchstaxClient.Client, got: %T.", req.ProviderData))
+		return
+	}
+	d.client = c
+}
+
+func (d *costEstimateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state costEstimateDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var uids []string
+	resp.Diagnostics.Append(state.DeploymentUIDs.ElementsAs(ctx, &uids, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountName := state.AccountName.ValueString()
+	// The catalog is read once per application, so plans of another
+	// application with the same name are never priced.
+	catalogs := make(map[string][]searchstaxClient.Plan)
+	state.Estimates = nil
+	total := 0.0
+	estimate := func(item costEstimateItemModel, attr path.Path) {
+		application := item.Application.ValueString()
+		plans, ok := catalogs[application]
+		if !ok {
+			catalog, err := d.client.GetAllPlans(ctx, accountName, application, "")
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(attr, "Unable to read plans", err.Error())
+				return
+			}
+			plans = catalog.Results
+			catalogs[application] = plans
+		}
+		item, err := estimateCost(plans, item)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(attr, "Unable to estimate cost", err.Error())
+			return
+		}
+		total += item.MonthlyEstimate.ValueFloat64()
+		state.Estimates = append(state.Estimates, item)
+	}
+
+	for i, spec := range state.Deployments {
+		estimate(costEstimateSpecItem(spec), path.Root("deployments").AtListIndex(i).AtName("plan"))
+	}
+	for i, uid := range uids {
+		dep, err := d.client.GetDeployment(ctx, accountName, uid)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("deployment_uids").AtListIndex(i), "Unable to read deployment", err.Error())
+			continue
+		}
+		estimate(costEstimateItemModel{
+			Name:                        types.StringValue(dep.Name),
+			UID:                         types.StringValue(uid),
+			Application:                 types.StringValue(dep.Application),
+			PlanType:                    types.StringValue(dep.PlanType),
+			Plan:                        types.StringValue(dep.Plan),
+			RegionID:                    types.StringValue(dep.RegionId),
+			CloudProviderID:             types.StringValue(dep.CloudProviderId),
+			NumAdditionalAppNodes:       types.Int64Value(dep.NumAdditionalAppNodes),
+			NumAdditionalZookeeperNodes: types.Int64Value(dep.NumAdditionalZookeeperNodes),
+		}, path.Root("deployment_uids").AtListIndex(i))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.ID = types.StringValue("placeholder")
+	state.TotalMonthlyEstimate = types.Float64Value(total)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// costEstimateSpecItem returns the estimate entry of a planned deployment.
+func costEstimateSpecItem(spec costEstimateSpecModel) costEstimateItemModel {
+	return costEstimateItemModel{
+		Name:                        types.StringValue(spec.Name.ValueString()),
+		UID:                         types.StringValue(""),
+		Application:                 spec.Application,
+		PlanType:                    spec.PlanType,
+		Plan:                        spec.Plan,
+		RegionID:                    spec.RegionID,
+		CloudProviderID:             spec.CloudProviderID,
+		NumAdditionalAppNodes:       spec.NumAdditionalAppNodes,
+		NumAdditionalZookeeperNodes: spec.NumAdditionalZookeeperNodes,
+	}
+}
+
+// estimateCost fills in the prices and monthly estimate of item from the
+// catalog plans: the plan's price in the region plus the price of each
+// additional application and ZooKeeper node.
+func estimateCost(plans []searchstaxClient.Plan, item costEstimateItemModel) (costEstimateItemModel, error) {
+	region, ok := findPlanRegion(plans, item.Application.ValueString(), item.PlanType.ValueString(),
+		item.Plan.ValueString(), item.RegionID.ValueString(), item.CloudProviderID.ValueString())
+	if !ok {
+		return item, fmt.Errorf("the plan catalog has no price for %s plan %q of plan type %q in region %q on cloud provider %q",
+			item.Application.ValueString(), item.Plan.ValueString(), item.PlanType.ValueString(),
+			item.RegionID.ValueString(), item.CloudProviderID.ValueString())
+	}
+	appNodes := item.NumAdditionalAppNodes.ValueInt64()
+	zkNodes := item.NumAdditionalZookeeperNodes.ValueInt64()
+	item.NumAdditionalAppNodes = types.Int64Value(appNodes)
+	item.NumAdditionalZookeeperNodes = types.Int64Value(zkNodes)
+	item.PlanPrice = types.Float64Value(region.Price)
+	item.AdditionalAppNodesPrice = types.Float64Value(float64(appNodes) * region.AdditionalApplicationNodePrice)
+	item.AdditionalZookeeperNodesPrice = types.Float64Value(float64(zkNodes) * region.AdditionalZookeeperNodePrice)
+	item.MonthlyEstimate = types.Float64Value(region.Price + item.AdditionalAppNodesPrice.ValueFloat64() +
+		item.AdditionalZookeeperNodesPrice.ValueFloat64())
+	return item, nil
+}
+
+// findPlanRegion returns the catalog prices of plan (matched by plan or name)
+// of the given application and plan type in the given region and cloud
+// provider. Plans that do not name their application match any.
+func findPlanRegion(plans []searchstaxClient.Plan, application, planType, plan, regionID, cloudProviderID string) (searchstaxClient.PlanRegion, bool) {
+	for _, p := range plans {
+		if p.Plan != plan && p.Name != plan {
+			continue
+		}
+		if p.PlanType != planType || (p.Application != "" && !strings.EqualFold(p.Application, application)) {
+			continue
+		}
+		for _, r := range p.PlanRegions {
+			if r.RegionID == regionID && r.CloudProviderID == cloudProviderID {
+				return r, true
+			}
+		}
+	}
+	return searchstaxClient.PlanRegion{}, false
+}
+
+type costEstimateDataSourceModel struct {
+	ID                   types.String            `tfsdk:"id"`
+	AccountName          types.String            `tfsdk:"account_name"`
+	Deployments          []costEstimateSpecModel `tfsdk:"deployments"`
+	DeploymentUIDs       types.List              `tfsdk:"deployment_uids"`
+	Estimates            []costEstimateItemModel `tfsdk:"estimates"`
+	TotalMonthlyEstimate types.Float64           `tfsdk:"total_monthly_estimate"`
+}
+
+type costEstimateSpecModel struct {
+	Name                        types.String `tfsdk:"name"`
+	Application                 types.String `tfsdk:"application"`
+	PlanType                    types.String `tfsdk:"plan_type"`
+	Plan                        types.String `tfsdk:"plan"`
+	RegionID                    types.String `tfsdk:"region_id"`
+	CloudProviderID             types.String `tfsdk:"cloud_provider_id"`
+	NumAdditionalAppNodes       types.Int64  `tfsdk:"num_additional_app_nodes"`
+	NumAdditionalZookeeperNodes types.Int64  `tfsdk:"num_additional_zookeeper_nodes"`
+}
+
+type costEstimateItemModel struct {
+	Name                          types.String  `tfsdk:"name"`
+	UID                           types.String  `tfsdk:"uid"`
+	Application                   types.String  `tfsdk:"application"`
+	PlanType                      types.String  `tfsdk:"plan_type"`
+	Plan                          types.String  `tfsdk:"plan"`
+	RegionID                      types.String  `tfsdk:"region_id"`
+	CloudProviderID               types.String  `tfsdk:"cloud_provider_id"`
+	NumAdditionalAppNodes         types.Int64   `tfsdk:"num_additional_app_nodes"`
+	NumAdditionalZookeeperNodes   types.Int64   `tfsdk:"num_additional_zookeeper_nodes"`
+	PlanPrice                     types.Float64 `tfsdk:"plan_price"`
+	AdditionalAppNodesPrice       types.Float64 `tfsdk:"additional_app_nodes_price"`
+	AdditionalZookeeperNodesPrice types.Float64 `tfsdk:"additional_zookeeper_nodes_price"`
+	MonthlyEstimate               types.Float64 `tfsdk:"monthly_estimate"`
+}
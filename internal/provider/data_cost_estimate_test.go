@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCostEstimateDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `data "searchstax_cost_estimate" "test" {
+  account_name    = "test_account_name"
+  deployment_uids = ["ss123456"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.searchstax_cost_estimate.test", "estimates.#", "1"),
+					resource.TestCheckResourceAttr("data.searchstax_cost_estimate.test", "estimates.0.uid", "ss123456"),
+					resource.TestCheckResourceAttrSet("data.searchstax_cost_estimate.test", "estimates.0.monthly_estimate"),
+					resource.TestCheckResourceAttrSet("data.searchstax_cost_estimate.test", "total_monthly_estimate"),
+				),
+			},
+		},
+	})
+}
+
+func TestEstimateCost(t *testing.T) {
+	plans := []searchstaxClient.Plan{
+		{
+			Name:        "NDC4-GCP-G",
+			Plan:        "NDC4",
+			PlanType:    "DedicatedDeployment",
+			Application: "Solr",
+			PlanRegions: []searchstaxClient.PlanRegion{
+				{RegionID: "us-east1", CloudProviderID: "gcp", Price: 500, AdditionalApplicationNodePrice: 125.5, AdditionalZookeeperNodePrice: 40},
+				{RegionID: "us-west1", CloudProviderID: "gcp", Price: 550},
+			},
+		},
+		{
+			Name:        "NDC4-GCP-G",
+			Plan:        "NDC4",
+			PlanType:    "SharedDeployment",
+			Application: "Solr",
+			PlanRegions: []searchstaxClient.PlanRegion{{RegionID: "us-east1", CloudProviderID: "gcp", Price: 90}},
+		},
+		{
+			Name:        "NDC4-GCP-G",
+			Plan:        "NDC4",
+			PlanType:    "DedicatedDeployment",
+			Application: "Elasticsearch",
+			PlanRegions: []searchstaxClient.PlanRegion{{RegionID: "us-east1", CloudProviderID: "gcp", Price: 700}},
+		},
+	}
+	spec := func(modify func(*costEstimateSpecModel)) costEstimateSpecModel {
+		s := costEstimateSpecModel{
+			Name:            types.StringValue("search-prod"),
+			Application:     types.StringValue("solr"),
+			PlanType:        types.StringValue("DedicatedDeployment"),
+			Plan:            types.StringValue("NDC4-GCP-G"),
+			RegionID:        types.StringValue("us-east1"),
+			CloudProviderID: types.StringValue("gcp"),
+		}
+		if modify != nil {
+			modify(&s)
+		}
+		return s
+	}
+
+	tests := []struct {
+		name        string
+		spec        costEstimateSpecModel
+		wantPlan    float64
+		wantApp     float64
+		wantZK      float64
+		wantMonthly float64
+		wantErr     bool
+	}{
+		{
+			name:        "plan only",
+			spec:        spec(nil),
+			wantPlan:    500,
+			wantMonthly: 500,
+		},
+		{
+			name: "additional nodes",
+			spec: spec(func(s *costEstimateSpecModel) {
+				s.NumAdditionalAppNodes = types.Int64Value(2)
+				s.NumAdditionalZookeeperNodes = types.Int64Value(3)
+			}),
+			wantPlan:    500,
+			wantApp:     251,
+			wantZK:      120,
+			wantMonthly: 871,
+		},
+		{
+			name:        "plan type selects the price",
+			spec:        spec(func(s *costEstimateSpecModel) { s.PlanType = types.StringValue("SharedDeployment") }),
+			wantPlan:    90,
+			wantMonthly: 90,
+		},
+		{
+			name:        "application selects the price",
+			spec:        spec(func(s *costEstimateSpecModel) { s.Application = types.StringValue("Elasticsearch") }),
+			wantPlan:    700,
+			wantMonthly: 700,
+		},
+		{
+			name:    "plan not found",
+			spec:    spec(func(s *costEstimateSpecModel) { s.Plan = types.StringValue("NDC8") }),
+			wantErr: true,
+		},
+		{
+			name:    "region not found",
+			spec:    spec(func(s *costEstimateSpecModel) { s.RegionID = types.StringValue("eu-west1") }),
+			wantErr: true,
+		},
+		{
+			name:    "plan type not offered",
+			spec:    spec(func(s *costEstimateSpecModel) { s.PlanType = types.StringValue("Serverless") }),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := estimateCost(plans, costEstimateSpecItem(tt.spec))
+			if tt.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "has no price") {
+					t.Fatalf("got error %v, want a missing price error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Name.ValueString() != "search-prod" || got.UID.ValueString() != "" {
+				t.Errorf("got name %s and uid %q, want the spec's name and no uid", got.Name, got.UID.ValueString())
+			}
+			for _, c := range []struct {
+				attr      string
+				got, want float64
+			}{
+				{"plan_price", got.PlanPrice.ValueFloat64(), tt.wantPlan},
+				{"additional_app_nodes_price", got.AdditionalAppNodesPrice.ValueFloat64(), tt.wantApp},
+				{"additional_zookeeper_nodes_price", got.AdditionalZookeeperNodesPrice.ValueFloat64(), tt.wantZK},
+				{"monthly_estimate", got.MonthlyEstimate.ValueFloat64(), tt.wantMonthly},
+			} {
+				if c.got != c.want {
+					t.Errorf("%s = %v, want %v", c.attr, c.got, c.want)
+				}
+			}
+			if got.NumAdditionalAppNodes.IsNull() || got.NumAdditionalZookeeperNodes.IsNull() {
+				t.Errorf("node counts left null: %v, %v", got.NumAdditionalAppNodes, got.NumAdditionalZookeeperNodes)
+			}
+		})
+	}
+}
@@ -45,7 +45,7 @@ func (d *customJarsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetCustomJars(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := d.client.GetCustomJars(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read custom jars", err.Error())
 		return
@@ -45,7 +45,7 @@ func (d *deploymentDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	dep, err := d.client.GetDeployment(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	dep, err := d.client.GetDeployment(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read deployment", err.Error())
 		return
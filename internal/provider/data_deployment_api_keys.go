@@ -46,7 +46,7 @@ func (d *deploymentAPIKeysDataSource) Read(ctx context.Context, req datasource.R
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetDeploymentAPIKeys(state.AccountName.ValueString(), searchstaxClient.DeploymentAPIKeysRequest{
+	out, err := d.client.GetDeploymentAPIKeys(ctx, state.AccountName.ValueString(), searchstaxClient.DeploymentAPIKeysRequest{
 		Deployment: state.DeploymentUID.ValueString(),
 	})
 	if err != nil {
@@ -19,14 +19,14 @@ func (d *deploymentBackupsDataSource) Metadata(_ context.Context, req datasource
 	resp.TypeName = req.ProviderTypeName + "_deployment_backups"
 }
 func (d *deploymentBackupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{Attributes: map[string]schema.Attribute{
-		"id":             schema.StringAttribute{Computed: true},
-		"account_name":   schema.StringAttribute{Required: true},
-		"deployment_uid": schema.StringAttribute{Required: true},
-		"backups": schema.ListNestedAttribute{Computed: true, NestedObject: schema.NestedAttributeObject{Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{Computed: true},
-		}}},
-	}}
+	attributes := backupListAttributes()
+	attributes["id"] = schema.StringAttribute{Computed: true}
+	attributes["account_name"] = schema.StringAttribute{Required: true}
+	attributes["deployment_uid"] = schema.StringAttribute{Required: true}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the backups of a deployment, optionally filtered, newest first.",
+		Attributes:          attributes,
+	}
 }
 func (d *deploymentBackupsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -45,16 +45,23 @@ func (d *deploymentBackupsDataSource) Read(ctx context.Context, req datasource.R
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetDeploymentBackups(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := d.client.GetDeploymentBackups(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read deployment backups", err.Error())
 		return
 	}
 	state.ID = types.StringValue("placeholder")
-	state.Backups = nil
-	for _, b := range out.Results {
-		state.Backups = append(state.Backups, backupModel{ID: types.StringValue(b.ID)})
+	backups, diags := backupModels(ctx, out.Results, backupFilter{
+		Status:        state.Status,
+		CreatedAfter:  state.CreatedAfter,
+		CreatedBefore: state.CreatedBefore,
+		Newest:        state.Newest,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	state.Backups = backups
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -62,8 +69,9 @@ type deploymentBackupsDataSourceModel struct {
 	ID            types.String  `tfsdk:"id"`
 	AccountName   types.String  `tfsdk:"account_name"`
 	DeploymentUID types.String  `tfsdk:"deployment_uid"`
+	Status        types.String  `tfsdk:"status"`
+	CreatedAfter  types.String  `tfsdk:"created_after"`
+	CreatedBefore types.String  `tfsdk:"created_before"`
+	Newest        types.Int64   `tfsdk:"newest"`
 	Backups       []backupModel `tfsdk:"backups"`
 }
-type backupModel struct {
-	ID types.String `tfsdk:"id"`
-}
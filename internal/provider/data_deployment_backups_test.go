@@ -14,6 +14,18 @@ func TestAccDeploymentBackupsDataSource(t *testing.T) {
 				Config: providerConfig + `data "searchstax_deployment_backups" "test" {
   account_name   = "test_account_name"
   deployment_uid = "ss123456"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.searchstax_deployment_backups.test", "backups.#", "0"),
+				),
+			},
+			{
+				Config: providerConfig + `data "searchstax_deployment_backups" "test" {
+  account_name   = "test_account_name"
+  deployment_uid = "ss123456"
+  status         = "Success"
+  created_after  = "2024-01-01T00:00:00Z"
+  newest         = 1
 }`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.searchstax_deployment_backups.test", "backups.#", "0"),
@@ -51,7 +51,7 @@ func (d *deploymentCollectionsHealthDataSource) Read(ctx context.Context, req da
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetCollectionsHealth(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := d.client.GetCollectionsHealth(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read collections health", err.Error())
 		return
@@ -47,7 +47,7 @@ func (d *deploymentHealthDataSource) Read(ctx context.Context, req datasource.Re
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetDeploymentHealth(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := d.client.GetDeploymentHealth(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read deployment health", err.Error())
 		return
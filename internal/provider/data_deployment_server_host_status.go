@@ -50,7 +50,7 @@ func (d *deploymentServerHostStatusDataSource) Read(ctx context.Context, req dat
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetServerHostStatus(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Node.ValueString())
+	out, err := d.client.GetServerHostStatus(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Node.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read server host status", err.Error())
 		return
@@ -56,7 +56,7 @@ func (d *deploymentServersDataSource) Read(ctx context.Context, req datasource.R
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetDeploymentServers(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := d.client.GetDeploymentServers(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read deployment servers", err.Error())
 		return
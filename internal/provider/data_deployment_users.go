@@ -49,7 +49,7 @@ func (d *deploymentUsersDataSource) Read(ctx context.Context, req datasource.Rea
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := d.client.GetDeploymentUsers(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	list, err := d.client.GetDeploymentUsers(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read SearchStax deployment users", err.Error())
 		return
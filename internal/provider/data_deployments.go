@@ -51,7 +51,7 @@ func (d *deploymentsDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	deployments, err := d.client.GetDeployments(config.AccountName.ValueString())
+	deployments, err := d.client.GetDeployments(ctx, config.AccountName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read SearchStax Deployments", err.Error())
 		return
@@ -59,7 +59,7 @@ func (d *deploymentsByTagDataSource) Read(ctx context.Context, req datasource.Re
 	if !state.Operator.IsNull() {
 		reqBody.Operator = state.Operator.ValueString()
 	}
-	out, err := d.client.GetDeploymentsByTag(state.AccountName.ValueString(), reqBody)
+	out, err := d.client.GetDeploymentsByTag(ctx, state.AccountName.ValueString(), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read deployments by tag", err.Error())
 		return
@@ -47,7 +47,7 @@ func (d *dnsRecordDataSource) Read(ctx context.Context, req datasource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	record, err := d.client.GetDNSRecord(state.AccountName.ValueString(), state.Name.ValueString())
+	record, err := d.client.GetDNSRecord(ctx, state.AccountName.ValueString(), state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read DNS record", err.Error())
 		return
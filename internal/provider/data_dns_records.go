@@ -51,7 +51,7 @@ func (d *dnsRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := d.client.GetDNSRecords(state.AccountName.ValueString())
+	list, err := d.client.GetDNSRecords(ctx, state.AccountName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read SearchStax DNS Records", err.Error())
 		return
@@ -51,7 +51,7 @@ func (d *heartbeatsDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetHeartbeats(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := d.client.GetHeartbeats(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read heartbeats", err.Error())
 		return
@@ -49,7 +49,7 @@ func (d *incidentsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetIncidents(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := d.client.GetIncidents(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read incidents", err.Error())
 		return
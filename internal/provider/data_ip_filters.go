@@ -50,7 +50,7 @@ func (d *ipFiltersDataSource) Read(ctx context.Context, req datasource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := d.client.GetIPFilters(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	list, err := d.client.GetIPFilters(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read SearchStax IP filters", err.Error())
 		return
@@ -70,9 +70,9 @@ func (d *plansDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		err error
 	)
 	if page > 0 {
-		out, err = d.client.GetPlans(state.AccountName.ValueString(), state.Application.ValueString(), state.PlanType.ValueString(), page)
+		out, err = d.client.GetPlans(ctx, state.AccountName.ValueString(), state.Application.ValueString(), state.PlanType.ValueString(), page)
 	} else {
-		out, err = d.client.GetAllPlans(state.AccountName.ValueString(), state.Application.ValueString(), state.PlanType.ValueString())
+		out, err = d.client.GetAllPlans(ctx, state.AccountName.ValueString(), state.Application.ValueString(), state.PlanType.ValueString())
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read plans", err.Error())
@@ -80,7 +80,7 @@ func (d *privateVpcDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	privateVpc, err := d.client.GetPrivateVpc(config.AccountName.ValueString())
+	privateVpc, err := d.client.GetPrivateVpc(ctx, config.AccountName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read SearchStax PrivateVpc",
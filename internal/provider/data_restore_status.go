@@ -26,7 +26,11 @@ func (d *restoreStatusDataSource) Schema(_ context.Context, _ datasource.SchemaR
 		"deployment_uid": schema.StringAttribute{Required: true},
 		"backup_id":      schema.StringAttribute{Required: true},
 		"message":        schema.StringAttribute{Computed: true},
-		"status":         schema.StringAttribute{Computed: true},
+		"status": schema.StringAttribute{
+			Computed: true,
+			MarkdownDescription: "Coarse restore state derived from `message`: `Queued`, `In Progress`, `Completed`, `Failed`, " +
+				"`None` (no restore running, e.g. \"No restore in progress\") or `Unknown` for an unrecognized message.",
+		},
 	}}
 }
 
@@ -49,14 +53,14 @@ func (d *restoreStatusDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 	reqBody := searchstaxClient.RestoreRequest{BackupID: state.BackupID.ValueString()}
-	out, err := d.client.GetDeploymentRestoreStatus(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), reqBody)
+	out, err := d.client.GetDeploymentRestoreStatus(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read restore status", err.Error())
 		return
 	}
 	state.ID = types.StringValue(state.AccountName.ValueString() + "/" + state.DeploymentUID.ValueString() + "/" + state.BackupID.ValueString())
 	state.Message = types.StringValue(out.Message)
-	state.Status = types.StringValue(restoreStatusFromMessage(out.Message))
+	state.Status = types.StringValue(searchstaxClient.RestoreStatus(out.Message))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
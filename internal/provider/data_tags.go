@@ -43,7 +43,7 @@ func (d *tagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetTags(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := d.client.GetTags(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read SearchStax tags", err.Error())
 		return
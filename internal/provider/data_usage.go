@@ -56,7 +56,7 @@ func (d *usageDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetUsage(state.AccountName.ValueString(), int(state.Year.ValueInt64()), int(state.Month.ValueInt64()))
+	out, err := d.client.GetUsage(ctx, state.AccountName.ValueString(), int(state.Year.ValueInt64()), int(state.Month.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read usage", err.Error())
 		return
@@ -71,7 +71,7 @@ func (d *usageExtendedDataSource) Read(ctx context.Context, req datasource.ReadR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetUsageExtended(state.AccountName.ValueString(), int(state.Year.ValueInt64()), int(state.Month.ValueInt64()))
+	out, err := d.client.GetUsageExtended(ctx, state.AccountName.ValueString(), int(state.Year.ValueInt64()), int(state.Month.ValueInt64()))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read extended usage", err.Error())
 		return
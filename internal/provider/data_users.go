@@ -70,7 +70,7 @@ func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	users, err := d.client.GetUsers()
+	users, err := d.client.GetUsers(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read SearchStax Users", err.Error())
 		return
@@ -47,7 +47,7 @@ func (d *webhooksDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := d.client.GetWebhooks(state.AccountName.ValueString())
+	list, err := d.client.GetWebhooks(ctx, state.AccountName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read SearchStax webhooks", err.Error())
 		return
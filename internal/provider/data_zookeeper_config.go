@@ -47,7 +47,7 @@ func (d *zookeeperConfigDataSource) Read(ctx context.Context, req datasource.Rea
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetZookeeperConfig(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Name.ValueString())
+	out, err := d.client.GetZookeeperConfig(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to read zookeeper config", err.Error())
 		return
@@ -50,7 +50,7 @@ func (d *zookeeperConfigDownloadDataSource) Read(ctx context.Context, req dataso
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.DownloadZookeeperConfig(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Name.ValueString())
+	out, err := d.client.DownloadZookeeperConfig(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to download zookeeper config", err.Error())
 		return
@@ -45,7 +45,7 @@ func (d *zookeeperConfigsDataSource) Read(ctx context.Context, req datasource.Re
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := d.client.GetZookeeperConfigs(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := d.client.GetZookeeperConfigs(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read zookeeper configs", err.Error())
 		return
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// findAdoptableDeployment looks for an existing deployment named like want.
+// Failed deployments and ones being deleted are skipped, as neither can be
+// adopted. It returns the deployment to adopt when exactly one has the same
+// name and spec (application, version, plan type, plan, region and cloud
+// provider), nil when no deployment has the name, and an error on the name
+// attribute naming the conflicting UIDs otherwise.
+func findAdoptableDeployment(existing []searchstaxClient.Deployment, want searchstaxClient.Deployment) (*searchstaxClient.Deployment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var matches []searchstaxClient.Deployment
+	var conflicts []string
+	for _, dep := range existing {
+		if dep.Name != want.Name || dep.Status == "Failed" || dep.Status == "Deleting" {
+			continue
+		}
+		if sameDeploymentSpec(dep, want) {
+			matches = append(matches, dep)
+			continue
+		}
+		conflicts = append(conflicts, fmt.Sprintf("%s (plan %s, region %s, %s %s, status %s)",
+			dep.UID, dep.Plan, dep.RegionId, dep.Application, dep.ApplicationVersion, dep.Status))
+	}
+
+	switch {
+	case len(matches) == 1:
+		return &matches[0], diags
+	case len(matches) > 1:
+		uids := make([]string, 0, len(matches))
+		for _, dep := range matches {
+			uids = append(uids, dep.UID)
+		}
+		diags.AddAttributeError(path.Root("name"), "Ambiguous Existing SearchStax Deployment",
+			fmt.Sprintf("adopt_existing is set, but %d deployments named %q match this configuration: %s. "+
+				"Import the one to manage with terraform import, or give the deployment another name.",
+				len(matches), want.Name, strings.Join(uids, ", ")))
+	case len(conflicts) > 0:
+		diags.AddAttributeError(path.Root("name"), "SearchStax Deployment Name Conflict",
+			fmt.Sprintf("adopt_existing is set, but the existing deployment named %q does not match this "+
+				"configuration, so it was not adopted and no deployment was created: %s. Align the configuration "+
+				"with it, import it with terraform import, or give the new deployment another name.",
+				want.Name, strings.Join(conflicts, "; ")))
+	}
+	return nil, diags
+}
+
+// sameDeploymentSpec reports whether an existing deployment was created with
+// the settings the configuration asks for.
+func sameDeploymentSpec(dep, want searchstaxClient.Deployment) bool {
+	return strings.EqualFold(dep.Application, want.Application) &&
+		dep.ApplicationVersion == want.ApplicationVersion &&
+		dep.PlanType == want.PlanType &&
+		dep.Plan == want.Plan &&
+		dep.RegionId == want.RegionId &&
+		dep.CloudProviderId == want.CloudProviderId
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+)
+
+func TestFindAdoptableDeployment(t *testing.T) {
+	want := searchstaxClient.Deployment{
+		Name:               "search",
+		Application:        "Solr",
+		ApplicationVersion: "9.4.1",
+		PlanType:           "DedicatedDeployment",
+		Plan:               "NDC4",
+		RegionId:           "us-east1",
+		CloudProviderId:    "gcp",
+	}
+	existing := func(uid, status string, modify func(*searchstaxClient.Deployment)) searchstaxClient.Deployment {
+		dep := want
+		dep.UID, dep.Status = uid, status
+		if modify != nil {
+			modify(&dep)
+		}
+		return dep
+	}
+
+	tests := []struct {
+		name      string
+		existing  []searchstaxClient.Deployment
+		wantUID   string
+		wantError string
+	}{
+		{
+			name: "no deployment with the name",
+			existing: []searchstaxClient.Deployment{
+				existing("ss1", "Running", func(d *searchstaxClient.Deployment) { d.Name = "other" }),
+			},
+		},
+		{
+			name: "exactly one match",
+			existing: []searchstaxClient.Deployment{
+				existing("ss1", "Running", func(d *searchstaxClient.Deployment) { d.Name = "other" }),
+				existing("ss2", "Running", func(d *searchstaxClient.Deployment) { d.Application = "solr" }),
+			},
+			wantUID: "ss2",
+		},
+		{
+			name: "several matches",
+			existing: []searchstaxClient.Deployment{
+				existing("ss1", "Running", nil),
+				existing("ss2", "Running", nil),
+			},
+			wantError: "Ambiguous Existing SearchStax Deployment",
+		},
+		{
+			name: "same name with a different spec",
+			existing: []searchstaxClient.Deployment{
+				existing("ss1", "Running", func(d *searchstaxClient.Deployment) { d.Plan = "NDC8" }),
+			},
+			wantError: "SearchStax Deployment Name Conflict",
+		},
+		{
+			name: "failed and deleting deployments are skipped",
+			existing: []searchstaxClient.Deployment{
+				existing("ss1", "Failed", nil),
+				existing("ss2", "Deleting", nil),
+				existing("ss3", "Deleting", func(d *searchstaxClient.Deployment) { d.Plan = "NDC8" }),
+			},
+		},
+		{
+			name: "match next to a failed deployment",
+			existing: []searchstaxClient.Deployment{
+				existing("ss1", "Failed", nil),
+				existing("ss2", "Running", nil),
+			},
+			wantUID: "ss2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := findAdoptableDeployment(tt.existing, want)
+			if tt.wantError != "" {
+				if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantError {
+					t.Fatalf("got %v, want a %q error", diags, tt.wantError)
+				}
+				if got != nil {
+					t.Errorf("got deployment %s alongside an error", got.UID)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			switch {
+			case tt.wantUID == "" && got != nil:
+				t.Errorf("got deployment %s, want none", got.UID)
+			case tt.wantUID != "" && (got == nil || got.UID != tt.wantUID):
+				t.Errorf("got %v, want deployment %s", got, tt.wantUID)
+			}
+		})
+	}
+
+	t.Run("conflict names the deployment", func(t *testing.T) {
+		_, diags := findAdoptableDeployment([]searchstaxClient.Deployment{
+			existing("ss9", "Running", func(d *searchstaxClient.Deployment) { d.RegionId = "us-west1" }),
+		}, want)
+		if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "ss9 (plan NDC4, region us-west1") {
+			t.Errorf("conflict does not name the deployment: %v", diags)
+		}
+	})
+}
+
+func TestSameDeploymentSpec(t *testing.T) {
+	want := searchstaxClient.Deployment{
+		Application:        "Solr",
+		ApplicationVersion: "9.4.1",
+		PlanType:           "DedicatedDeployment",
+		Plan:               "NDC4",
+		RegionId:           "us-east1",
+		CloudProviderId:    "gcp",
+	}
+	tests := []struct {
+		name   string
+		modify func(*searchstaxClient.Deployment)
+		want   bool
+	}{
+		{name: "identical", modify: func(*searchstaxClient.Deployment) {}, want: true},
+		{name: "application case differs", modify: func(d *searchstaxClient.Deployment) { d.Application = "SOLR" }, want: true},
+		{name: "other fields ignored", modify: func(d *searchstaxClient.Deployment) { d.UID, d.Tier = "ss1", "Production" }, want: true},
+		{name: "application version", modify: func(d *searchstaxClient.Deployment) { d.ApplicationVersion = "8.11.2" }},
+		{name: "plan type", modify: func(d *searchstaxClient.Deployment) { d.PlanType = "SharedDeployment" }},
+		{name: "plan", modify: func(d *searchstaxClient.Deployment) { d.Plan = "NDC8" }},
+		{name: "region", modify: func(d *searchstaxClient.Deployment) { d.RegionId = "us-west1" }},
+		{name: "cloud provider", modify: func(d *searchstaxClient.Deployment) { d.CloudProviderId = "aws" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dep := want
+			tt.modify(&dep)
+			if got := sameDeploymentSpec(dep, want); got != tt.want {
+				t.Errorf("sameDeploymentSpec() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// deploymentCatalogChanged reports whether plan sets any catalog attribute to
+// a new value, so the catalog only has to be read when creating a deployment
+// or changing one of them.
+func deploymentCatalogChanged(plan, state deploymentModel) bool {
+	return !plan.PlanType.Equal(state.PlanType) ||
+		!plan.Plan.Equal(state.Plan) ||
+		!plan.ApplicationVersion.Equal(state.ApplicationVersion) ||
+		!plan.RegionId.Equal(state.RegionId) ||
+		!plan.CloudProviderId.Equal(state.CloudProviderId)
+}
+
+// deploymentCatalogKnown reports whether every catalog attribute of plan is
+// known; values computed from other resources are checked by the API instead.
+func deploymentCatalogKnown(plan deploymentModel) bool {
+	return !plan.PlanType.IsUnknown() && !plan.Plan.IsUnknown() && !plan.ApplicationVersion.IsUnknown() &&
+		!plan.RegionId.IsUnknown() && !plan.CloudProviderId.IsUnknown()
+}
+
+// validateDeploymentCatalog checks plan_type, plan, application_version,
+// region_id and cloud_provider_id against the plans offered for the
+// deployment's application and reports each invalid value on its attribute,
+// listing the valid choices. Checks stop at the first invalid attribute, as
+// the later choices depend on it. A plan with unknown catalog attributes is
+// not checked.
+func validateDeploymentCatalog(plan deploymentModel, catalog []searchstaxClient.Plan) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if len(catalog) == 0 || !deploymentCatalogKnown(plan) {
+		return diags
+	}
+
+	planType := plan.PlanType.ValueString()
+	var ofType []searchstaxClient.Plan
+	var planTypes []string
+	for _, p := range catalog {
+		planTypes = append(planTypes, p.PlanType)
+		if p.PlanType == planType {
+			ofType = append(ofType, p)
+		}
+	}
+	if len(ofType) == 0 {
+		diags.AddAttributeError(path.Root("plan_type"), "Invalid Deployment Plan Type",
+			catalogChoices("plan_type", planType, "application "+plan.Application.ValueString(), planTypes))
+		return diags
+	}
+
+	var selected *searchstaxClient.Plan
+	var planNames []string
+	for i, p := range ofType {
+		planNames = append(planNames, p.Plan)
+		if p.Plan == plan.Plan.ValueString() || p.Name == plan.Plan.ValueString() {
+			selected = &ofType[i]
+		}
+	}
+	if selected == nil {
+		diags.AddAttributeError(path.Root("plan"), "Invalid Deployment Plan",
+			catalogChoices("plan", plan.Plan.ValueString(), "plan_type "+planType, planNames))
+		return diags
+	}
+
+	version := plan.ApplicationVersion.ValueString()
+	if len(selected.ApplicationVersions) > 0 && !slices.Contains(selected.ApplicationVersions, version) {
+		diags.AddAttributeError(path.Root("application_version"), "Invalid Deployment Application Version",
+			catalogChoices("application_version", version, "plan "+selected.Plan, selected.ApplicationVersions))
+		return diags
+	}
+
+	if len(selected.PlanRegions) == 0 {
+		return diags
+	}
+	regionID := plan.RegionId.ValueString()
+	var regions, cloudProviders []string
+	for _, r := range selected.PlanRegions {
+		regions = append(regions, r.RegionID)
+		if r.RegionID == regionID {
+			cloudProviders = append(cloudProviders, r.CloudProviderID)
+		}
+	}
+	if len(cloudProviders) == 0 {
+		diags.AddAttributeError(path.Root("region_id"), "Invalid Deployment Region",
+			catalogChoices("region_id", regionID, "plan "+selected.Plan, regions))
+		return diags
+	}
+	if cloudProviderID := plan.CloudProviderId.ValueString(); !slices.Contains(cloudProviders, cloudProviderID) {
+		diags.AddAttributeError(path.Root("cloud_provider_id"), "Invalid Deployment Cloud Provider",
+			catalogChoices("cloud_provider_id", cloudProviderID, fmt.Sprintf("plan %s in region %s", selected.Plan, regionID), cloudProviders))
+	}
+	return diags
+}
+
+// catalogChoices formats the detail of a catalog validation error, such as:
+//
+//	plan "NDC4" is not offered for plan_type DedicatedDeployment in the
+//	SearchStax plan catalog. Valid choices: "NDC4-GCP-G", "NDC8-GCP-G".
+func catalogChoices(attribute, value, scope string, choices []string) string {
+	seen := make(map[string]bool, len(choices))
+	var unique []string
+	for _, c := range choices {
+		if c != "" && !seen[c] {
+			seen[c] = true
+			unique = append(unique, fmt.Sprintf("%q", c))
+		}
+	}
+	sort.Strings(unique)
+	return fmt.Sprintf("%s %q is not offered for %s in the SearchStax plan catalog. Valid choices: %s.",
+		attribute, value, scope, strings.Join(unique, ", "))
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateDeploymentCatalog(t *testing.T) {
+	catalog := []searchstaxClient.Plan{
+		{
+			Name:                "NDN1-AWS-S",
+			Plan:                "NDN1",
+			PlanType:            "SharedDeployment",
+			ApplicationVersions: []string{"8.11.2"},
+		},
+		{
+			Name:                "NDC4-GCP-G",
+			Plan:                "NDC4",
+			PlanType:            "DedicatedDeployment",
+			ApplicationVersions: []string{"8.11.2", "9.4.1"},
+			PlanRegions: []searchstaxClient.PlanRegion{
+				{RegionID: "us-east1", CloudProviderID: "gcp"},
+				{RegionID: "us-west1", CloudProviderID: "gcp"},
+			},
+		},
+	}
+	valid := func() deploymentModel {
+		return deploymentModel{
+			Application:        types.StringValue("Solr"),
+			PlanType:           types.StringValue("DedicatedDeployment"),
+			Plan:               types.StringValue("NDC4"),
+			ApplicationVersion: types.StringValue("9.4.1"),
+			RegionId:           types.StringValue("us-east1"),
+			CloudProviderId:    types.StringValue("gcp"),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(*deploymentModel)
+		wantAttr string
+		wantText string
+	}{
+		{
+			name:   "valid spec",
+			modify: func(*deploymentModel) {},
+		},
+		{
+			name:   "plan matched by name",
+			modify: func(p *deploymentModel) { p.Plan = types.StringValue("NDC4-GCP-G") },
+		},
+		{
+			name:     "unknown plan_type",
+			modify:   func(p *deploymentModel) { p.PlanType = types.StringValue("Serverless") },
+			wantAttr: "plan_type",
+			wantText: `Valid choices: "DedicatedDeployment", "SharedDeployment".`,
+		},
+		{
+			name:     "unknown plan",
+			modify:   func(p *deploymentModel) { p.Plan = types.StringValue("NDC99") },
+			wantAttr: "plan",
+			wantText: `Valid choices: "NDC4".`,
+		},
+		{
+			name:     "plan of another plan_type",
+			modify:   func(p *deploymentModel) { p.Plan = types.StringValue("NDN1") },
+			wantAttr: "plan",
+			wantText: `Valid choices: "NDC4".`,
+		},
+		{
+			name:     "unknown solr version",
+			modify:   func(p *deploymentModel) { p.ApplicationVersion = types.StringValue("7.7.3") },
+			wantAttr: "application_version",
+			wantText: `Valid choices: "8.11.2", "9.4.1".`,
+		},
+		{
+			name:     "unknown region",
+			modify:   func(p *deploymentModel) { p.RegionId = types.StringValue("eu-west1") },
+			wantAttr: "region_id",
+			wantText: `Valid choices: "us-east1", "us-west1".`,
+		},
+		{
+			name:     "unknown cloud provider",
+			modify:   func(p *deploymentModel) { p.CloudProviderId = types.StringValue("aws") },
+			wantAttr: "cloud_provider_id",
+			wantText: `Valid choices: "gcp".`,
+		},
+		{
+			name: "computed catalog values",
+			modify: func(p *deploymentModel) {
+				p.PlanType = types.StringValue("Serverless")
+				p.Plan = types.StringUnknown()
+				p.RegionId = types.StringUnknown()
+			},
+		},
+		{
+			name:   "computed version",
+			modify: func(p *deploymentModel) { p.ApplicationVersion = types.StringUnknown() },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := valid()
+			tt.modify(&plan)
+			diags := validateDeploymentCatalog(plan, catalog)
+			if tt.wantAttr == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				return
+			}
+			if diags.ErrorsCount() != 1 {
+				t.Fatalf("got %d errors, want 1: %v", diags.ErrorsCount(), diags)
+			}
+			d, ok := diags.Errors()[0].(interface{ Path() path.Path })
+			if !ok || !d.Path().Equal(path.Root(tt.wantAttr)) {
+				t.Errorf("error not reported on %s: %v", tt.wantAttr, diags)
+			}
+			if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, tt.wantText) {
+				t.Errorf("detail %q does not contain %q", detail, tt.wantText)
+			}
+		})
+	}
+
+	t.Run("empty catalog", func(t *testing.T) {
+		plan := valid()
+		plan.PlanType = types.StringValue("Serverless")
+		if diags := validateDeploymentCatalog(plan, nil); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+	})
+}
+
+func TestCatalogChoices(t *testing.T) {
+	tests := []struct {
+		name    string
+		choices []string
+		want    string
+	}{
+		{
+			name:    "sorted and deduplicated",
+			choices: []string{"NDC8", "NDC4", "NDC8"},
+			want:    `plan "NDC2" is not offered for plan_type DedicatedDeployment in the SearchStax plan catalog. Valid choices: "NDC4", "NDC8".`,
+		},
+		{
+			name:    "empty choices are dropped",
+			choices: []string{"", "NDC4"},
+			want:    `plan "NDC2" is not offered for plan_type DedicatedDeployment in the SearchStax plan catalog. Valid choices: "NDC4".`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := catalogChoices("plan", "NDC2", "plan_type DedicatedDeployment", tt.choices); got != tt.want {
+				t.Errorf("catalogChoices() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// deploymentSourceModel maps the source block of a deployment cloned from a
+// backup.
+type deploymentSourceModel struct {
+	DeploymentUID types.String `tfsdk:"deployment_uid"`
+	BackupID      types.String `tfsdk:"backup_id"`
+	RestoredID    types.String `tfsdk:"restored_backup_id"`
+}
+
+// validateDeploymentSource checks that a source block names exactly one of a
+// source deployment or a backup, that the create waits for the deployment to
+// be ready, which the restore needs, and that it is not combined with
+// adopt_existing, which would restore the backup over an existing
+// deployment's live data.
+func validateDeploymentSource(plan deploymentModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	source := plan.Source
+	if source == nil || source.DeploymentUID.IsUnknown() || source.BackupID.IsUnknown() {
+		return diags
+	}
+	hasDeployment := source.DeploymentUID.ValueString() != ""
+	hasBackup := source.BackupID.ValueString() != ""
+	if hasDeployment == hasBackup {
+		diags.AddAttributeError(path.Root("source"), "Invalid Deployment Source",
+			"Set exactly one of source.deployment_uid (clone a fresh backup of that deployment) or "+
+				"source.backup_id (restore an existing backup).")
+	}
+	if plan.AdoptExisting.ValueBool() {
+		diags.AddAttributeError(path.Root("adopt_existing"), "Invalid Deployment Source",
+			"adopt_existing cannot be combined with a source block: adopting an existing deployment would "+
+				"restore the backup into it and overwrite its data. Remove adopt_existing to create a new "+
+				"deployment from the backup, or remove the source block to adopt the existing one.")
+	}
+	if !waitForDeploymentReady(plan) {
+		diags.AddAttributeError(path.Root("wait_for_ready"), "Invalid Deployment Source",
+			"A deployment with a source block is only created once the backup has been restored into it, "+
+				"so wait_for_ready cannot be false.")
+	}
+	return diags
+}
+
+// restoreDeploymentSource restores the backup named by plan's source block
+// into the newly provisioned deployment and waits for the restore to finish.
+// With source.deployment_uid, a new backup of that deployment is taken first.
+// An account backup is restored through the account restore endpoint, a
+// deployment backup through the deployment one. The restored backup ID is
+// recorded in plan.
+func (d *deploymentResource) restoreDeploymentSource(ctx context.Context, plan *deploymentModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	accountName, uid := plan.AccountName.ValueString(), plan.UID.ValueString()
+	backupID := plan.Source.BackupID.ValueString()
+
+	if sourceUID := plan.Source.DeploymentUID.ValueString(); sourceUID != "" {
+		tflog.Info(ctx, "Backing up source SearchStax deployment to clone it", map[string]interface{}{
+			"account_name":          accountName,
+			"source_deployment_uid": sourceUID,
+		})
+		backup, err := d.client.CreateDeploymentBackup(ctx, accountName, sourceUID, map[string]any{})
+		if err != nil {
+			diags.Append(apiErrorDiagnostics(
+				"Error Cloning SearchStax Deployment",
+				"Could not back up source deployment "+sourceUID,
+				err,
+			)...)
+			return diags
+		}
+		if err := d.client.WaitForDeploymentBackup(ctx, accountName, sourceUID, backup.BackupID); err != nil {
+			if timeoutDiag, ok := timeoutDiagnostic(err, "create"); ok {
+				diags.Append(timeoutDiag)
+				return diags
+			}
+			diags.AddError(
+				"Error Cloning SearchStax Deployment",
+				fmt.Sprintf("Backup %s of source deployment %s did not complete: %s", backup.BackupID, sourceUID, err),
+			)
+			return diags
+		}
+		backupID = backup.BackupID
+	}
+
+	accountBackup := false
+	if plan.Source.DeploymentUID.ValueString() == "" {
+		var err error
+		if accountBackup, err = d.isAccountBackup(ctx, accountName, backupID); err != nil {
+			diags.Append(apiErrorDiagnostics(
+				"Error Cloning SearchStax Deployment",
+				"Could not list the account backups to look up backup "+backupID,
+				err,
+			)...)
+			return diags
+		}
+	}
+
+	tflog.Info(ctx, "Restoring backup into new SearchStax deployment", map[string]interface{}{
+		"account_name":   accountName,
+		"deployment_uid": uid,
+		"backup_id":      backupID,
+		"account_backup": accountBackup,
+	})
+	var err error
+	if accountBackup {
+		_, err = d.client.CreateAccountRestore(ctx, accountName, searchstaxClient.RestoreRequest{BackupID: backupID, DeploymentUID: uid})
+	} else {
+		_, err = d.client.CreateDeploymentRestore(ctx, accountName, uid, searchstaxClient.RestoreRequest{BackupID: backupID})
+	}
+	if err != nil {
+		diags.Append(apiErrorDiagnostics(
+			"Error Cloning SearchStax Deployment",
+			fmt.Sprintf("Could not restore backup %s into deployment %s", backupID, uid),
+			err,
+		)...)
+		return diags
+	}
+	if _, err := d.client.WaitForDeploymentRestore(ctx, accountName, uid, backupID); err != nil {
+		if timeoutDiag, ok := timeoutDiagnostic(err, "create"); ok {
+			diags.Append(timeoutDiag)
+			return diags
+		}
+		diags.AddError(
+			"Error Cloning SearchStax Deployment",
+			fmt.Sprintf("Backup %s was not restored into deployment %s: %s", backupID, uid, err),
+		)
+		return diags
+	}
+	plan.Source.RestoredID = types.StringValue(backupID)
+	tflog.Info(ctx, "Restored backup into new SearchStax deployment", map[string]interface{}{
+		"account_name":   accountName,
+		"deployment_uid": uid,
+		"backup_id":      backupID,
+	})
+	return diags
+}
+
+// isAccountBackup reports whether backupID is one of the account's backups
+// rather than a deployment backup.
+func (d *deploymentResource) isAccountBackup(ctx context.Context, accountName, backupID string) (bool, error) {
+	backups, err := d.client.GetAccountBackups(ctx, accountName)
+	if err != nil {
+		return false, err
+	}
+	for _, b := range backups.Results {
+		if b.ID == backupID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateDeploymentSource(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    deploymentModel
+		wantErr bool
+	}{
+		{
+			name: "no source",
+			plan: deploymentModel{AdoptExisting: types.BoolValue(true)},
+		},
+		{
+			name: "backup source",
+			plan: deploymentModel{Source: &deploymentSourceModel{BackupID: types.StringValue("b1")}},
+		},
+		{
+			name: "both deployment and backup",
+			plan: deploymentModel{Source: &deploymentSourceModel{
+				DeploymentUID: types.StringValue("ss1"),
+				BackupID:      types.StringValue("b1"),
+			}},
+			wantErr: true,
+		},
+		{
+			name: "adopt_existing with a source",
+			plan: deploymentModel{
+				AdoptExisting: types.BoolValue(true),
+				Source:        &deploymentSourceModel{BackupID: types.StringValue("b1")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "source without waiting for ready",
+			plan: deploymentModel{
+				WaitForReady: types.BoolValue(false),
+				Source:       &deploymentSourceModel{BackupID: types.StringValue("b1")},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateDeploymentSource(tt.plan).HasError(); got != tt.wantErr {
+				t.Fatalf("validateDeploymentSource error = %v, want %v", got, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deploymentScaleAttributes are the attributes that can change on an existing
+// deployment through the scaling API; API field errors on them are reported
+// against the attribute.
+var deploymentScaleAttributes = []string{"plan", "num_additional_app_nodes", "num_additional_zookeeper_nodes"}
+
+// deploymentScaleChanged reports whether plan resizes the deployment in state:
+// a different plan tier or a configured node count that differs from the
+// current one.
+func deploymentScaleChanged(plan, state deploymentModel) bool {
+	if !plan.Plan.IsUnknown() && !plan.Plan.Equal(state.Plan) {
+		return true
+	}
+	return int64Changed(plan.NumAdditionalAppNodes, state.NumAdditionalAppNodes) ||
+		int64Changed(plan.NumAdditionalZookeeperNodes, state.NumAdditionalZookeeperNodes)
+}
+
+// deploymentScaleRequest builds the scaling request that moves the deployment
+// in state to the size in plan, sending only what changes.
+func deploymentScaleRequest(plan, state deploymentModel) searchstaxClient.DeploymentScaleRequest {
+	var scale searchstaxClient.DeploymentScaleRequest
+	if !plan.Plan.Equal(state.Plan) {
+		scale.Plan = plan.Plan.ValueString()
+	}
+	if int64Changed(plan.NumAdditionalAppNodes, state.NumAdditionalAppNodes) {
+		nodes := plan.NumAdditionalAppNodes.ValueInt64()
+		scale.NumAdditionalAppNodes = &nodes
+	}
+	if int64Changed(plan.NumAdditionalZookeeperNodes, state.NumAdditionalZookeeperNodes) {
+		nodes := plan.NumAdditionalZookeeperNodes.ValueInt64()
+		scale.NumAdditionalZookeeperNodes = &nodes
+	}
+	return scale
+}
+
+// markDeploymentSizeUnknown clears the computed attributes that SearchStax
+// derives from the deployment's size, so the plan shows them as known after
+// apply instead of promising the current values.
+func markDeploymentSizeUnknown(plan *deploymentModel) {
+	plan.Tier = types.StringUnknown()
+	plan.DesiredTier = types.StringUnknown()
+	plan.Status = types.StringUnknown()
+	plan.ProvisionState = types.StringUnknown()
+	plan.NumNodesDefault = types.Int64Unknown()
+	plan.NumZookeeperNodesDefault = types.Int64Unknown()
+	plan.ApplicationNodesCount = types.Int64Unknown()
+	plan.Servers = types.ListUnknown(types.StringType)
+	plan.ZookeeperEnsemble = types.StringUnknown()
+	plan.SpecJVMHeapMemory = types.StringUnknown()
+	plan.SpecDiskSpace = types.StringUnknown()
+	plan.SpecPhysicalMemory = types.StringUnknown()
+}
+
+// int64Changed reports whether a configured value differs from the current
+// one. Null (not configured) and unknown values leave the current value as is.
+func int64Changed(planned, current types.Int64) bool {
+	if planned.IsNull() || planned.IsUnknown() {
+		return false
+	}
+	return !planned.Equal(current)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Character classes of generated Solr basic-auth passwords. Look-alike
+// characters are left out, and the symbols need no quoting in URLs, shells or
+// the JSON of Solr's security.json.
+const (
+	solrPasswordLower   = "abcdefghijkmnopqrstuvwxyz"
+	solrPasswordUpper   = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	solrPasswordDigits  = "23456789"
+	solrPasswordSymbols = "-_.~!@#%^+="
+)
+
+// solrPasswordLength is the length of generated passwords.
+const solrPasswordLength = 24
+
+// generateSolrPassword returns a random password of solrPasswordLength
+// characters with at least one lower case letter, upper case letter, digit
+// and symbol.
+func generateSolrPassword() (string, error) {
+	classes := []string{solrPasswordLower, solrPasswordUpper, solrPasswordDigits, solrPasswordSymbols}
+	all := solrPasswordLower + solrPasswordUpper + solrPasswordDigits + solrPasswordSymbols
+
+	password := make([]byte, 0, solrPasswordLength)
+	for _, class := range classes {
+		c, err := randomChar(class)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+	for len(password) < solrPasswordLength {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	// Shuffle so the required classes are not always in the first positions.
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", fmt.Errorf("generating password: %w", err)
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+// randomChar returns a uniformly random character of chars.
+func randomChar(chars string) (byte, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, fmt.Errorf("generating password: %w", err)
+	}
+	return chars[i.Int64()], nil
+}
+
+// writeOnlyPasswordDue reports whether updating state to plan sets the
+// password from password_wo. The write-only value is not in state to compare
+// with, so it is only sent when its version changes or when it replaces
+// password or a generated password.
+func writeOnlyPasswordDue(plan, state deploymentUserModel) bool {
+	return !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) || !state.Password.IsNull() ||
+		state.GeneratePassword.ValueBool()
+}
+
+// passwordRotationDue reports whether a password generated at generatedAt, an
+// RFC 3339 time, is due for rotation after rotationDays at now. A password
+// without a readable generation time is due.
+func passwordRotationDue(generatedAt types.String, rotationDays types.Int64, now time.Time) bool {
+	if rotationDays.IsNull() || rotationDays.IsUnknown() {
+		return false
+	}
+	generated, err := time.Parse(time.RFC3339, generatedAt.ValueString())
+	if err != nil {
+		return true
+	}
+	return !now.Before(generated.AddDate(0, 0, int(rotationDays.ValueInt64())))
+}
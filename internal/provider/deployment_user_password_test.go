@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestGenerateSolrPassword(t *testing.T) {
+	seen := map[string]bool{}
+	for i := 0; i < 50; i++ {
+		password, err := generateSolrPassword()
+		if err != nil {
+			t.Fatalf("generateSolrPassword: %v", err)
+		}
+		if len(password) != solrPasswordLength {
+			t.Fatalf("password %q has length %d, want %d", password, len(password), solrPasswordLength)
+		}
+		for _, class := range []string{solrPasswordLower, solrPasswordUpper, solrPasswordDigits, solrPasswordSymbols} {
+			if !strings.ContainsAny(password, class) {
+				t.Fatalf("password %q has no character of %q", password, class)
+			}
+		}
+		if seen[password] {
+			t.Fatalf("password %q generated twice", password)
+		}
+		seen[password] = true
+	}
+}
+
+func TestPasswordRotationDue(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name         string
+		generatedAt  types.String
+		rotationDays types.Int64
+		want         bool
+	}{
+		{"no rotation", types.StringValue("2020-01-01T00:00:00Z"), types.Int64Null(), false},
+		{"recent", types.StringValue("2024-02-20T12:00:00Z"), types.Int64Value(30), false},
+		{"expired", types.StringValue("2024-01-31T12:00:00Z"), types.Int64Value(30), true},
+		{"exactly due", types.StringValue("2024-02-01T12:00:00Z"), types.Int64Value(29), true},
+		{"unreadable", types.StringNull(), types.Int64Value(30), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := passwordRotationDue(tt.generatedAt, tt.rotationDays, now); got != tt.want {
+				t.Errorf("passwordRotationDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteOnlyPasswordDue(t *testing.T) {
+	writeOnly := deploymentUserModel{
+		Password:          types.StringNull(),
+		PasswordWOVersion: types.Int64Value(1),
+		GeneratePassword:  types.BoolNull(),
+	}
+	tests := []struct {
+		name  string
+		state func(*deploymentUserModel)
+		plan  func(*deploymentUserModel)
+		want  bool
+	}{
+		{
+			name: "same version",
+		},
+		{
+			name: "version bumped",
+			plan: func(m *deploymentUserModel) { m.PasswordWOVersion = types.Int64Value(2) },
+			want: true,
+		},
+		{
+			name:  "version added",
+			state: func(m *deploymentUserModel) { m.PasswordWOVersion = types.Int64Null() },
+			want:  true,
+		},
+		{
+			name:  "replaces password",
+			state: func(m *deploymentUserModel) { m.Password = types.StringValue("old") },
+			want:  true,
+		},
+		{
+			name:  "replaces a generated password with the same version",
+			state: func(m *deploymentUserModel) { m.GeneratePassword = types.BoolValue(true) },
+			want:  true,
+		},
+		{
+			name:  "generate_password false in state",
+			state: func(m *deploymentUserModel) { m.GeneratePassword = types.BoolValue(false) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, plan := writeOnly, writeOnly
+			if tt.state != nil {
+				tt.state(&state)
+			}
+			if tt.plan != nil {
+				tt.plan(&plan)
+			}
+			if got := writeOnlyPasswordDue(plan, state); got != tt.want {
+				t.Errorf("writeOnlyPasswordDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
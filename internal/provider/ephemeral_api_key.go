@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithClose = &apiKeyEphemeralResource{}
+
+func NewAPIKeyEphemeralResource() ephemeral.EphemeralResource { return &apiKeyEphemeralResource{} }
+
+type apiKeyEphemeralResource struct{ client *searchstaxClient.Client }
+
+// apiKeyPrivateKey is the private data key holding the API key to revoke on
+// Close.
+const apiKeyPrivateKey = "api_key"
+
+// apiKeyPrivateData is the API key recorded by Open for Close.
+type apiKeyPrivateData struct {
+	AccountName string `json:"account_name"`
+	APIKey      string `json:"api_key"`
+}
+
+func (e *apiKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (e *apiKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Creates a SearchStax account API key that is never written to the plan or state. " +
+			"The key is revoked once Terraform no longer needs it, so it is only usable during the run.",
+		Attributes: map[string]schema.Attribute{
+			"account_name": schema.StringAttribute{Required: true},
+			"scope": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Scopes of the API key, such as `deployment.dedicateddeployment`.",
+			},
+			"api_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The API key, sent as `Authorization: APIkey <api_key>`.",
+			},
+		},
+	}
+}
+
+func (e *apiKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*searchstaxClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type", fmt.Sprintf("Expected *searchstaxClient.Client, got: %T.", req.ProviderData))
+		return
+	}
+	e.client = c
+}
+
+func (e *apiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config apiKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var scope []string
+	resp.Diagnostics.Append(config.Scope.ElementsAs(ctx, &scope, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := e.client.CreateAPIKey(ctx, config.AccountName.ValueString(), searchstaxClient.CreateAPIKeyRequest{Scope: scope})
+	if err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error creating API key", "Could not create API key", err, "scope")...)
+		return
+	}
+	config.APIKey = types.StringValue(out.APIKey)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+
+	private, err := json.Marshal(apiKeyPrivateData{AccountName: config.AccountName.ValueString(), APIKey: out.APIKey})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating API key", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyPrivateKey, private)...)
+}
+
+// Close revokes the API key created by Open.
+func (e *apiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, apiKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+	var key apiKeyPrivateData
+	if err := json.Unmarshal(private, &key); err != nil {
+		resp.Diagnostics.AddError("Error revoking API key", err.Error())
+		return
+	}
+	if key.APIKey == "" {
+		return
+	}
+	if err := e.client.RevokeAPIKey(ctx, key.AccountName, searchstaxClient.RevokeAPIKeyRequest{APIKey: key.APIKey}); err != nil {
+		resp.Diagnostics.AddError("Error revoking API key", err.Error())
+	}
+}
+
+type apiKeyEphemeralResourceModel struct {
+	AccountName types.String `tfsdk:"account_name"`
+	Scope       types.List   `tfsdk:"scope"`
+	APIKey      types.String `tfsdk:"api_key"`
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithClose = &authTokenEphemeralResource{}
+
+func NewAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &authTokenEphemeralResource{}
+}
+
+type authTokenEphemeralResource struct{ client *searchstaxClient.Client }
+
+// authTokenPrivateKey is the private data key holding the token to sign out
+// on Close.
+const authTokenPrivateKey = "auth_token"
+
+func (e *authTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_token"
+}
+
+func (e *authTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Signs in to SearchStax and returns a bearer token that is never written to the plan or state. " +
+			"The token is signed out once Terraform no longer needs it.\n\n" +
+			"Without `username` and `password`, the provider's own credentials are used. Signing the provider's user " +
+			"in again may revoke the token the provider holds; the provider then signs in again on its next request.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "User to sign in as. Defaults to the provider's `username`.",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password of `username`. Defaults to the provider's `password`.",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The bearer token, sent as `Authorization: Token <token>`.",
+			},
+		},
+	}
+}
+
+func (e *authTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*searchstaxClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Ephemeral Resource Configure Type", fmt.Sprintf("Expected *searchstaxClient.Client, got: %T.", req.ProviderData))
+		return
+	}
+	e.client = c
+}
+
+func (e *authTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config authTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	auth := e.client.Auth
+	if !config.Username.IsNull() {
+		auth.Username = config.Username.ValueString()
+	}
+	if !config.Password.IsNull() {
+		auth.Password = config.Password.ValueString()
+	}
+	if auth.Username == "" || auth.Password == "" {
+		resp.Diagnostics.AddError("Unable to obtain SearchStax auth token",
+			"Set username and password, or configure the provider with a username and password.")
+		return
+	}
+
+	token, err := e.client.GetUserTokenSignIn(ctx, auth)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to obtain SearchStax auth token", err.Error())
+		return
+	}
+	config.Token = types.StringValue(token.Token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+
+	private, err := json.Marshal(token.Token)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to obtain SearchStax auth token", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, authTokenPrivateKey, private)...)
+}
+
+// Close signs out the token returned by Open.
+func (e *authTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, authTokenPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+	var token string
+	if err := json.Unmarshal(private, &token); err != nil {
+		resp.Diagnostics.AddError("Error signing out SearchStax auth token", err.Error())
+		return
+	}
+	if token == "" {
+		return
+	}
+	if err := e.client.SignOut(ctx, &token); err != nil {
+		resp.Diagnostics.AddError("Error signing out SearchStax auth token", err.Error())
+	}
+}
+
+type authTokenEphemeralResourceModel struct {
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Token    types.String `tfsdk:"token"`
+}
@@ -2,10 +2,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
+
 	searchstaxClient "terraform-provider-searchstax/internal/client"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -16,7 +20,8 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &searchstaxProvider{}
+	_ provider.Provider                       = &searchstaxProvider{}
+	_ provider.ProviderWithEphemeralResources = &searchstaxProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -38,9 +43,17 @@ type searchstaxProvider struct {
 
 // searchstaxProviderModel maps provider schema data to a Go type.
 type searchstaxProviderModel struct {
-	Host     types.String `tfsdk:"host"`
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Host         types.String `tfsdk:"host"`
+	Username     types.String `tfsdk:"username"`
+	Password     types.String `tfsdk:"password"`
+	APIKey       types.String `tfsdk:"api_key"`
+	Token        types.String `tfsdk:"token"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
+
+	RequestsPerSecond           types.Float64 `tfsdk:"requests_per_second"`
+	SerializeDeploymentRequests types.Bool    `tfsdk:"serialize_deployment_requests"`
+	DeletionProtection          types.Bool    `tfsdk:"deletion_protection"`
 }
 
 // Metadata returns the provider type name.
@@ -52,6 +65,10 @@ func (p *searchstaxProvider) Metadata(_ context.Context, _ provider.MetadataRequ
 // Schema defines the provider-level schema for configuration data.
 func (p *searchstaxProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "Configure exactly one way to authenticate: `username` and `password` (the provider signs in " +
+			"and obtains a token), an account `api_key`, or an existing auth `token`. When none of them is set in the " +
+			"configuration they are read from the `SEARCHSTAX_USERNAME`/`SEARCHSTAX_PASSWORD`, `SEARCHSTAX_API_KEY` or " +
+			"`SEARCHSTAX_TOKEN` environment variables.",
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Optional: true,
@@ -63,6 +80,48 @@ func (p *searchstaxProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:  true,
 				Sensitive: true,
 			},
+			"api_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "SearchStax account API key, sent instead of signing in with a username and password. Can also be set with the `SEARCHSTAX_API_KEY` environment variable.",
+			},
+			"token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Existing SearchStax auth token, sent instead of signing in with a username and password. The provider cannot renew it, so it must stay valid for the whole run. Can also be set with the `SEARCHSTAX_TOKEN` environment variable.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("How many times a failed API request is retried. Reads and a few read-only POSTs are "+
+					"retried on network errors, 5xx and 429 responses; other requests are only retried when the API rejected them "+
+					"without acting (429, or a deployment still applying a previous change). Defaults to `%d`; `0` disables retries.",
+					searchstaxClient.DefaultMaxRetries),
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: fmt.Sprintf("Longest wait between two attempts of a request, as a duration such as `\"10s\"` or `\"2m\"`. "+
+					"Retries back off exponentially with jitter, or follow the API's `Retry-After` header, up to this limit. Defaults to `%q`.",
+					searchstaxClient.DefaultRetryMaxWait.String()),
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional: true,
+				MarkdownDescription: "Maximum number of API requests per second, shared by all resources and data sources of this " +
+					"provider configuration (retries included). Use it to stay under the SearchStax request limits when many " +
+					"resources run in parallel. Unlimited when unset.",
+			},
+			"serialize_deployment_requests": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "When `true`, send at most one request that changes a given deployment at a time. The API " +
+					"rejects a change while the deployment is still applying another one, so this avoids those errors when " +
+					"several resources target the same deployment. Defaults to `false`.",
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "When `true`, `searchstax_deployment` resources refuse to be destroyed unless they set " +
+					"`allow_replacement = true`, so removing one from the configuration or running `terraform destroy` " +
+					"fails at plan time. Replacing a deployment is always refused without `allow_replacement`. " +
+					"Defaults to `false`.",
+			},
 		},
 	}
 }
@@ -110,33 +169,84 @@ func (p *searchstaxProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	if config.APIKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Unknown SearchStax API Key",
+			"The provider cannot create the SearchStax API client as there is an unknown configuration value for the SearchStax API key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEARCHSTAX_API_KEY environment variable.",
+		)
+	}
+
+	if config.Token.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Unknown SearchStax API Token",
+			"The provider cannot create the SearchStax API client as there is an unknown configuration value for the SearchStax API token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the SEARCHSTAX_TOKEN environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Default values to environment variables, but override
-	// with Terraform configuration value if set.
+	// with Terraform configuration value if set. Credentials set in the
+	// configuration replace the environment ones entirely, so a leftover
+	// SEARCHSTAX_PASSWORD does not conflict with a configured api_key.
 
 	host := os.Getenv("SEARCHSTAX_HOST")
 	username := os.Getenv("SEARCHSTAX_USERNAME")
 	password := os.Getenv("SEARCHSTAX_PASSWORD")
+	apiKey := os.Getenv("SEARCHSTAX_API_KEY")
+	token := os.Getenv("SEARCHSTAX_TOKEN")
 
 	if !config.Host.IsNull() {
 		host = config.Host.ValueString()
 	}
 
-	if !config.Username.IsNull() {
+	if !config.Username.IsNull() || !config.Password.IsNull() || !config.APIKey.IsNull() || !config.Token.IsNull() {
 		username = config.Username.ValueString()
+		password = config.Password.ValueString()
+		apiKey = config.APIKey.ValueString()
+		token = config.Token.ValueString()
 	}
 
-	if !config.Password.IsNull() {
-		password = config.Password.ValueString()
+	// Exactly one authentication mode must be configured.
+
+	passwordAuth := username != "" || password != ""
+	authModes := 0
+	for _, configured := range []bool{passwordAuth, apiKey != "", token != ""} {
+		if configured {
+			authModes++
+		}
+	}
+
+	if authModes == 0 {
+		resp.Diagnostics.AddError(
+			"Missing SearchStax API Credentials",
+			"The provider cannot create the SearchStax API client as no credentials are configured. "+
+				"Set username and password, api_key, or token in the configuration, or use the SEARCHSTAX_USERNAME and SEARCHSTAX_PASSWORD, "+
+				"SEARCHSTAX_API_KEY or SEARCHSTAX_TOKEN environment variables. "+
+				"If any is already set, ensure the value is not empty.",
+		)
+		return
+	}
+
+	if authModes > 1 {
+		resp.Diagnostics.AddError(
+			"Conflicting SearchStax API Credentials",
+			"The provider cannot create the SearchStax API client as more than one authentication mode is configured. "+
+				"Set only one of username and password, api_key, or token.",
+		)
+		return
 	}
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if username == "" {
+	if passwordAuth && username == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
 			"Missing SearchStax API Username",
@@ -146,7 +256,7 @@ func (p *searchstaxProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
-	if password == "" {
+	if passwordAuth && password == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
 			"Missing SearchStax API Password",
@@ -156,12 +266,66 @@ func (p *searchstaxProvider) Configure(ctx context.Context, req provider.Configu
 		)
 	}
 
+	maxRetries := -1
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+		if maxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid SearchStax Max Retries",
+				fmt.Sprintf("max_retries must be zero or greater, got %d.", maxRetries),
+			)
+		}
+	}
+
+	var retryMaxWait time.Duration
+	if !config.RetryMaxWait.IsNull() && !config.RetryMaxWait.IsUnknown() {
+		var err error
+		retryMaxWait, err = time.ParseDuration(config.RetryMaxWait.ValueString())
+		if err != nil || retryMaxWait <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_wait"),
+				"Invalid SearchStax Retry Max Wait",
+				fmt.Sprintf("retry_max_wait must be a positive duration such as \"30s\" or \"2m\", got %q.", config.RetryMaxWait.ValueString()),
+			)
+		}
+	}
+
+	var requestsPerSecond float64
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		requestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+		if requestsPerSecond <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid SearchStax Requests Per Second",
+				fmt.Sprintf("requests_per_second must be greater than zero, got %v.", requestsPerSecond),
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Create a new SearchStax client using the configuration values
-	client, err := searchstaxClient.NewClient(&host, &username, &password)
+	// Create a new SearchStax client using the configuration values. Only
+	// username/password authentication signs in; an API key or token is sent
+	// as is.
+	opts := []searchstaxClient.Option{
+		searchstaxClient.WithRetry(maxRetries, retryMaxWait),
+		searchstaxClient.WithRateLimit(requestsPerSecond),
+		searchstaxClient.WithDeploymentSerialization(config.SerializeDeploymentRequests.ValueBool()),
+		searchstaxClient.WithDeletionProtection(config.DeletionProtection.ValueBool()),
+	}
+	var usernamePtr, passwordPtr *string
+	switch {
+	case passwordAuth:
+		usernamePtr, passwordPtr = &username, &password
+	case apiKey != "":
+		opts = append(opts, searchstaxClient.WithAPIKey(apiKey))
+	default:
+		opts = append(opts, searchstaxClient.WithToken(token))
+	}
+	client, err := searchstaxClient.NewClient(ctx, &host, usernamePtr, passwordPtr, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create SearchStax API Client",
@@ -172,10 +336,11 @@ func (p *searchstaxProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	// Make the SearchStax client available during DataSource and Resource
-	// type Configure methods.
+	// Make the SearchStax client available during DataSource, Resource and
+	// EphemeralResource type Configure methods.
 	resp.DataSourceData = client
 	resp.ResourceData = client
+	resp.EphemeralResourceData = client
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -188,6 +353,7 @@ func (p *searchstaxProvider) DataSources(_ context.Context) []func() datasource.
 		NewAuthTokenDataSource,
 		NewBackupSchedulesDataSource,
 		NewBasicAuthDataSource,
+		NewCostEstimateDataSource,
 		NewCustomJarsDataSource,
 		NewDeploymentAPIKeysDataSource,
 		NewDeploymentBackupsDataSource,
@@ -218,6 +384,14 @@ func (p *searchstaxProvider) DataSources(_ context.Context) []func() datasource.
 	}
 }
 
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *searchstaxProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPIKeyEphemeralResource,
+		NewAuthTokenEphemeralResource,
+	}
+}
+
 // Resources defines the resources implemented in the provider.
 func (p *searchstaxProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
@@ -226,6 +400,7 @@ func (p *searchstaxProvider) Resources(_ context.Context) []func() resource.Reso
 		NewAPIKeyAssociationResource,
 		NewAPIKeyResource,
 		NewAuthSessionResource,
+		NewBackupRetentionPolicyResource,
 		NewBackupScheduleResource,
 		NewBasicAuthResource,
 		NewCustomJarResource,
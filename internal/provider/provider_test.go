@@ -3,6 +3,7 @@ package provider
 import (
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 )
 
 const (
@@ -25,3 +26,15 @@ var (
 		"searchstax": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// testAccProtoV6ProviderFactoriesWithEcho adds the echo provider, whose
+// echo resource exposes ephemeral values to test checks.
+func testAccProtoV6ProviderFactoriesWithEcho() map[string]func() (tfprotov6.ProviderServer, error) {
+	factories := map[string]func() (tfprotov6.ProviderServer, error){
+		"echo": echoprovider.NewProviderServer(),
+	}
+	for name, factory := range testAccProtoV6ProviderFactories {
+		factories[name] = factory
+	}
+	return factories
+}
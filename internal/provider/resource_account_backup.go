@@ -57,7 +57,7 @@ func (r *accountBackupResource) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := r.client.GetAccountBackups(state.AccountName.ValueString())
+	list, err := r.client.GetAccountBackups(ctx, state.AccountName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading account backups", err.Error())
 		return
@@ -82,7 +82,7 @@ func (r *accountBackupResource) Delete(ctx context.Context, req resource.DeleteR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.DeleteAccountBackup(state.AccountName.ValueString(), state.BackupID.ValueString()); err != nil {
+	if err := r.client.DeleteAccountBackup(ctx, state.AccountName.ValueString(), state.BackupID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting account backup", err.Error())
 	}
 }
@@ -46,7 +46,7 @@ func (r *alertResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	id, err := r.client.CreateAlert(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.Alert{})
+	id, err := r.client.CreateAlert(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.Alert{})
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating alert", err.Error())
 		return
@@ -61,7 +61,7 @@ func (r *alertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := r.client.GetAlerts(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	list, err := r.client.GetAlerts(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading alerts", err.Error())
 		return
@@ -86,7 +86,7 @@ func (r *alertResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.UpdateAlert(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.AlertID.ValueInt64(), searchstaxClient.Alert{}); err != nil {
+	if err := r.client.UpdateAlert(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.AlertID.ValueInt64(), searchstaxClient.Alert{}); err != nil {
 		resp.Diagnostics.AddError("Error updating alert", err.Error())
 		return
 	}
@@ -98,7 +98,7 @@ func (r *alertResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.DeleteAlert(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.AlertID.ValueInt64()); err != nil {
+	if err := r.client.DeleteAlert(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.AlertID.ValueInt64()); err != nil {
 		resp.Diagnostics.AddError("Error deleting alert", err.Error())
 	}
 }
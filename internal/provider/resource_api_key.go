@@ -52,9 +52,9 @@ func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	out, err := r.client.CreateAPIKey(plan.AccountName.ValueString(), searchstaxClient.CreateAPIKeyRequest{Scope: scope})
+	out, err := r.client.CreateAPIKey(ctx, plan.AccountName.ValueString(), searchstaxClient.CreateAPIKeyRequest{Scope: scope})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating API key", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error creating API key", "Could not create API key", err, "scope")...)
 		return
 	}
 	plan.APIKey = types.StringValue(out.APIKey)
@@ -79,7 +79,7 @@ func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.RevokeAPIKey(state.AccountName.ValueString(), searchstaxClient.RevokeAPIKeyRequest{APIKey: state.APIKey.ValueString()}); err != nil {
+	if err := r.client.RevokeAPIKey(ctx, state.AccountName.ValueString(), searchstaxClient.RevokeAPIKeyRequest{APIKey: state.APIKey.ValueString()}); err != nil {
 		resp.Diagnostics.AddError("Error revoking API key", err.Error())
 	}
 }
@@ -48,7 +48,7 @@ func (r *apiKeyAssociationResource) Create(ctx context.Context, req resource.Cre
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_, err := r.client.AssociateAPIKey(plan.AccountName.ValueString(), searchstaxClient.AssociateAPIKeyRequest{
+	_, err := r.client.AssociateAPIKey(ctx, plan.AccountName.ValueString(), searchstaxClient.AssociateAPIKeyRequest{
 		APIKey:     plan.APIKey.ValueString(),
 		Deployment: plan.DeploymentUID.ValueString(),
 	})
@@ -66,7 +66,7 @@ func (r *apiKeyAssociationResource) Read(ctx context.Context, req resource.ReadR
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := r.client.GetDeploymentAPIKeys(state.AccountName.ValueString(), searchstaxClient.DeploymentAPIKeysRequest{
+	out, err := r.client.GetDeploymentAPIKeys(ctx, state.AccountName.ValueString(), searchstaxClient.DeploymentAPIKeysRequest{
 		Deployment: state.DeploymentUID.ValueString(),
 	})
 	if err != nil {
@@ -92,7 +92,7 @@ func (r *apiKeyAssociationResource) Delete(ctx context.Context, req resource.Del
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_, err := r.client.DisassociateAPIKey(state.AccountName.ValueString(), searchstaxClient.AssociateAPIKeyRequest{
+	_, err := r.client.DisassociateAPIKey(ctx, state.AccountName.ValueString(), searchstaxClient.AssociateAPIKeyRequest{
 		APIKey:     state.APIKey.ValueString(),
 		Deployment: state.DeploymentUID.ValueString(),
 	})
@@ -36,7 +36,7 @@ func (r *authSessionResource) Configure(_ context.Context, req resource.Configur
 	r.client = c
 }
 func (r *authSessionResource) Create(ctx context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
-	token, err := r.client.SignIn()
+	token, err := r.client.SignIn(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating auth session", err.Error())
 		return
@@ -68,7 +68,7 @@ func (r *authSessionResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 	// best-effort sign out (mock may not expose this endpoint)
 	token := state.Token.ValueString()
-	_ = r.client.SignOut(&token)
+	_ = r.client.SignOut(ctx, &token)
 }
 
 type authSessionResourceModel struct {
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.ResourceWithModifyPlan = &backupRetentionPolicyResource{}
+
+func NewBackupRetentionPolicyResource() resource.Resource { return &backupRetentionPolicyResource{} }
+
+type backupRetentionPolicyResource struct{ client *searchstaxClient.Client }
+
+func (r *backupRetentionPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_retention_policy"
+}
+
+func (r *backupRetentionPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Prunes the backups of a deployment, or the account backups when `deployment_uid` is not " +
+			"set, on every apply.\n\n" +
+			"A backup is deleted when it is older than `max_age_days`, or when `keep_last` successful backups are " +
+			"newer than it. Backups still running or without a reported status, and backups without a readable date, " +
+			"are never deleted. Since the policy runs on every apply, `deleted_backup_ids` is always shown as changing " +
+			"in the plan. Destroying the resource stops the pruning and deletes nothing.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{Computed: true},
+			"account_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"deployment_uid": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Deployment whose backups are pruned. When not set, the account backups are pruned.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_age_days": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Delete backups taken more than this many days ago. Must be at least 1.",
+			},
+			"keep_last": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "Keep only this many of the newest successful backups, deleting older ones. Must " +
+					"be at least 1.",
+			},
+			"dry_run": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "When `true`, nothing is deleted and `deleted_backup_ids` lists the backups that " +
+					"would have been. Defaults to `false`.",
+			},
+			"deleted_backup_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "IDs of the backups deleted (or, with `dry_run`, to delete) by the last apply.",
+			},
+		},
+	}
+}
+
+func (r *backupRetentionPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	c, ok := req.ProviderData.(*searchstaxClient.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Resource Configure Type", fmt.Sprintf("Expected *searchstaxClient.Client, got: %T.", req.ProviderData))
+		return
+	}
+	r.client = c
+}
+
+// ModifyPlan validates the policy and, since it runs on every apply, always
+// plans deleted_backup_ids as unknown.
+func (r *backupRetentionPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan backupRetentionPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateBackupRetentionPolicy(plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.DeletedBackupIDs = types.ListUnknown(types.StringType)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func (r *backupRetentionPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan backupRetentionPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(plan.AccountName.ValueString())
+	if !plan.DeploymentUID.IsNull() {
+		plan.ID = types.StringValue(plan.AccountName.ValueString() + "/" + plan.DeploymentUID.ValueString())
+	}
+	resp.Diagnostics.Append(r.prune(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *backupRetentionPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state backupRetentionPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *backupRetentionPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state backupRetentionPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = state.ID
+	resp.Diagnostics.Append(r.prune(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the policy from state; the remaining backups are kept.
+func (r *backupRetentionPolicyResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+// prune lists the backups, deletes the ones the policy in plan does not keep
+// and records their IDs in plan. A backup that cannot be deleted is reported
+// as an error after the others have been tried.
+func (r *backupRetentionPolicyResource) prune(ctx context.Context, plan *backupRetentionPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	accountName, deploymentUID := plan.AccountName.ValueString(), plan.DeploymentUID.ValueString()
+
+	var list *searchstaxClient.BackupsList
+	var err error
+	if plan.DeploymentUID.IsNull() {
+		list, err = r.client.GetAccountBackups(ctx, accountName)
+	} else {
+		list, err = r.client.GetDeploymentBackups(ctx, accountName, deploymentUID)
+	}
+	if err != nil {
+		diags.AddError("Error listing backups", err.Error())
+		plan.DeletedBackupIDs = types.ListValueMust(types.StringType, nil)
+		return diags
+	}
+
+	deleted := []string{}
+	for _, b := range expiredBackups(list.Results, plan.KeepLast, plan.MaxAgeDays, time.Now()) {
+		fields := map[string]interface{}{
+			"account_name":   accountName,
+			"deployment_uid": deploymentUID,
+			"backup_id":      b.ID,
+			"date":           b.Date,
+		}
+		if plan.DryRun.ValueBool() {
+			tflog.Info(ctx, "Backup retention policy would delete backup (dry run)", fields)
+			deleted = append(deleted, b.ID)
+			continue
+		}
+		if plan.DeploymentUID.IsNull() {
+			err = r.client.DeleteAccountBackup(ctx, accountName, b.ID)
+		} else {
+			err = r.client.DeleteDeploymentBackup(ctx, accountName, deploymentUID, b.ID)
+		}
+		if err != nil {
+			diags.AddError("Error deleting backup", fmt.Sprintf("Could not delete backup %s: %s", b.ID, err))
+			continue
+		}
+		tflog.Info(ctx, "Backup retention policy deleted backup", fields)
+		deleted = append(deleted, b.ID)
+	}
+	deletedIDs, d := types.ListValueFrom(ctx, types.StringType, deleted)
+	diags.Append(d...)
+	plan.DeletedBackupIDs = deletedIDs
+	return diags
+}
+
+// expiredBackups returns the backups to delete, newest first: finished backups
+// taken more than maxAgeDays before now, and those older than the newest
+// keepLast successful backups. Backups that are neither reported successful
+// nor failed (still running, or listed without a status) and undated backups
+// are kept.
+func expiredBackups(backups []searchstaxClient.Backup, keepLast, maxAgeDays types.Int64, now time.Time) []searchstaxClient.Backup {
+	var expired []searchstaxClient.Backup
+	successful := int64(0)
+	for _, b := range sortBackupsNewestFirst(backups) {
+		if !b.dated || (!b.backup.Succeeded() && !b.backup.Failed()) {
+			continue
+		}
+		tooMany := !keepLast.IsNull() && successful >= keepLast.ValueInt64()
+		tooOld := !maxAgeDays.IsNull() && b.created.Before(now.AddDate(0, 0, -int(maxAgeDays.ValueInt64())))
+		if b.backup.Succeeded() {
+			successful++
+		}
+		if tooMany || tooOld {
+			expired = append(expired, b.backup)
+		}
+	}
+	return expired
+}
+
+// validateBackupRetentionPolicy checks that the policy sets a limit and that
+// its limits are at least 1; a limit of 0 would delete every backup.
+func validateBackupRetentionPolicy(plan backupRetentionPolicyResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if plan.KeepLast.IsNull() && plan.MaxAgeDays.IsNull() {
+		diags.AddError("Invalid Backup Retention Policy", "Set keep_last, max_age_days or both.")
+	}
+	if !plan.KeepLast.IsNull() && !plan.KeepLast.IsUnknown() && plan.KeepLast.ValueInt64() < 1 {
+		diags.AddAttributeError(path.Root("keep_last"), "Invalid Backup Retention Policy",
+			fmt.Sprintf("keep_last must be at least 1, got %d; 0 would delete every backup.", plan.KeepLast.ValueInt64()))
+	}
+	if !plan.MaxAgeDays.IsNull() && !plan.MaxAgeDays.IsUnknown() && plan.MaxAgeDays.ValueInt64() < 1 {
+		diags.AddAttributeError(path.Root("max_age_days"), "Invalid Backup Retention Policy",
+			fmt.Sprintf("max_age_days must be at least 1, got %d; 0 would delete every backup.", plan.MaxAgeDays.ValueInt64()))
+	}
+	return diags
+}
+
+type backupRetentionPolicyResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	AccountName      types.String `tfsdk:"account_name"`
+	DeploymentUID    types.String `tfsdk:"deployment_uid"`
+	MaxAgeDays       types.Int64  `tfsdk:"max_age_days"`
+	KeepLast         types.Int64  `tfsdk:"keep_last"`
+	DryRun           types.Bool   `tfsdk:"dry_run"`
+	DeletedBackupIDs types.List   `tfsdk:"deleted_backup_ids"`
+}
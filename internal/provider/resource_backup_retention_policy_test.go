@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupRetentionPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "searchstax_backup_retention_policy" "test" {
+  account_name   = "test_account_name"
+  deployment_uid = "ss123456"
+  keep_last      = 5
+  max_age_days   = 30
+  dry_run        = true
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("searchstax_backup_retention_policy.test", "id", "test_account_name/ss123456"),
+					resource.TestCheckResourceAttr("searchstax_backup_retention_policy.test", "deleted_backup_ids.#", "0"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestExpiredBackups(t *testing.T) {
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+	backups := []searchstaxClient.Backup{
+		{ID: "old-success", Date: "2024-01-01T00:00:00Z", Status: "Success"},
+		{ID: "new-success", Date: "2024-03-30T00:00:00Z", Status: "Success"},
+		{ID: "mid-success", Date: "2024-03-20T00:00:00Z", Status: "Success"},
+		{ID: "old-failed", Date: "2024-01-02T00:00:00Z", Status: "Failed"},
+		{ID: "old-running", Date: "2024-01-03T00:00:00Z", Status: "In Progress"},
+		{ID: "old-unreported", Date: "2024-01-04T00:00:00Z"},
+		{ID: "undated", Date: "last tuesday", Status: "Success"},
+	}
+	tests := []struct {
+		name       string
+		keepLast   types.Int64
+		maxAgeDays types.Int64
+		want       []string
+	}{
+		{
+			name:       "keep last two",
+			keepLast:   types.Int64Value(2),
+			maxAgeDays: types.Int64Null(),
+			want:       []string{"old-failed", "old-success"},
+		},
+		{
+			name:       "max age of thirty days",
+			keepLast:   types.Int64Null(),
+			maxAgeDays: types.Int64Value(30),
+			want:       []string{"old-failed", "old-success"},
+		},
+		{
+			name:       "keep last one",
+			keepLast:   types.Int64Value(1),
+			maxAgeDays: types.Int64Null(),
+			want:       []string{"mid-success", "old-failed", "old-success"},
+		},
+		{
+			name:       "both limits",
+			keepLast:   types.Int64Value(5),
+			maxAgeDays: types.Int64Value(5),
+			want:       []string{"mid-success", "old-failed", "old-success"},
+		},
+		{
+			name:       "generous limits",
+			keepLast:   types.Int64Value(10),
+			maxAgeDays: types.Int64Value(365),
+			want:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, b := range expiredBackups(backups, tt.keepLast, tt.maxAgeDays, now) {
+				got = append(got, b.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("expiredBackups() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateBackupRetentionPolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		keepLast   types.Int64
+		maxAgeDays types.Int64
+		wantErr    bool
+	}{
+		{"keep_last only", types.Int64Value(3), types.Int64Null(), false},
+		{"max_age_days only", types.Int64Null(), types.Int64Value(7), false},
+		{"no limit", types.Int64Null(), types.Int64Null(), true},
+		{"keep_last zero", types.Int64Value(0), types.Int64Null(), true},
+		{"max_age_days zero", types.Int64Null(), types.Int64Value(0), true},
+		{"negative keep_last", types.Int64Value(-1), types.Int64Value(7), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := backupRetentionPolicyResourceModel{KeepLast: tt.keepLast, MaxAgeDays: tt.maxAgeDays}
+			if got := validateBackupRetentionPolicy(plan).HasError(); got != tt.wantErr {
+				t.Fatalf("validateBackupRetentionPolicy error = %v, want %v", got, tt.wantErr)
+			}
+		})
+	}
+}
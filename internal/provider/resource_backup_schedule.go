@@ -3,18 +3,40 @@ package provider
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
+	"regexp"
 	"strings"
 
 	searchstaxClient "terraform-provider-searchstax/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+var _ resource.ResourceWithModifyPlan = &backupScheduleResource{}
+
+// Limits of the backup schedule settings accepted by the API.
+const (
+	minBackupRetention = 1
+	maxBackupRetention = 365
+	minBackupFrequency = 1
+	maxBackupFrequency = 24
+)
+
+// backupScheduleTime matches a 24-hour HH:MM time.
+var backupScheduleTime = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`)
+
+// backupScheduleDays are the day names a schedule accepts, matched
+// case-insensitively, with their three-letter abbreviations.
+var backupScheduleDays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
 func NewBackupScheduleResource() resource.Resource { return &backupScheduleResource{} }
 
 type backupScheduleResource struct{ client *searchstaxClient.Client }
@@ -24,18 +46,40 @@ func (r *backupScheduleResource) Metadata(_ context.Context, req resource.Metada
 }
 
 func (r *backupScheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{Attributes: map[string]schema.Attribute{
-		"id":             schema.StringAttribute{Computed: true},
-		"account_name":   schema.StringAttribute{Required: true},
-		"deployment_uid": schema.StringAttribute{Required: true},
-		"schedule_id":    schema.StringAttribute{Computed: true},
-		"days":           schema.ListAttribute{Required: true, ElementType: types.StringType},
-		"retention":      schema.Int64Attribute{Required: true},
-		"region_id":      schema.StringAttribute{Required: true},
-		"time":           schema.StringAttribute{Optional: true},
-		"frequency":      schema.Int64Attribute{Optional: true},
-		"collections":    schema.ListAttribute{Optional: true, ElementType: types.StringType},
-	}}
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a backup schedule of a deployment.\n\n" +
+			"The SearchStax API cannot change a schedule, so changing `days`, `time`, `retention`, `frequency`, " +
+			"`region_id` or `collections` creates the new schedule first and only then deletes the old one, so the " +
+			"deployment is never left without a schedule. Only when the days and time stay the same and the API " +
+			"refuses a second schedule in that slot is the old schedule deleted first, and restored if the new one " +
+			"cannot be created. Any other error leaves the old schedule in place. Changing `account_name` or " +
+			"`deployment_uid` likewise creates the schedule on the new deployment before deleting the old one.",
+		Attributes: map[string]schema.Attribute{
+			"id":             schema.StringAttribute{Computed: true},
+			"account_name":   schema.StringAttribute{Required: true},
+			"deployment_uid": schema.StringAttribute{Required: true},
+			"schedule_id":    schema.StringAttribute{Computed: true},
+			"days": schema.ListAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Days to back up on: English day names such as `Monday`, or their three-letter abbreviations such as `mon`.",
+			},
+			"retention": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: fmt.Sprintf("Number of backups to keep, from %d to %d.", minBackupRetention, maxBackupRetention),
+			},
+			"region_id": schema.StringAttribute{Required: true},
+			"time": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Time of day to back up at, as 24-hour `HH:MM`.",
+			},
+			"frequency": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Hours between backups, from %d to %d.", minBackupFrequency, maxBackupFrequency),
+			},
+			"collections": schema.ListAttribute{Optional: true, ElementType: types.StringType},
+		},
+	}
 }
 
 func (r *backupScheduleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -87,19 +131,98 @@ func normalizeTime(t string) string {
 	return t
 }
 
-func (r *backupScheduleResource) findSchedule(accountName, deploymentUID string, days []string, retention int64, time string) (string, bool) {
-	list, err := r.client.GetBackupSchedules(accountName, deploymentUID)
+// scheduleMatches reports whether s has the given days, retention and time.
+func scheduleMatches(s searchstaxClient.BackupSchedule, days []string, retention int64, time string) bool {
+	return int64(s.Retention) == retention && normalizeTime(s.Time) == normalizeTime(time) && reflect.DeepEqual(s.Days, days)
+}
+
+// findSchedule looks up the id of a schedule with the given attributes,
+// ignoring the schedules in skip.
+func (r *backupScheduleResource) findSchedule(ctx context.Context, accountName, deploymentUID string, days []string, retention int64, time string, skip map[string]bool) (string, bool) {
+	list, err := r.client.GetBackupSchedules(ctx, accountName, deploymentUID)
 	if err != nil {
 		return "", false
 	}
 	for _, s := range list.Results {
-		if int64(s.Retention) == retention && normalizeTime(s.Time) == normalizeTime(time) && reflect.DeepEqual(s.Days, days) {
+		if !skip[s.ID] && scheduleMatches(s, days, retention, time) {
 			return s.ID, true
 		}
 	}
 	return "", false
 }
 
+// scheduleIDs returns the ids of the deployment's current schedules.
+func (r *backupScheduleResource) scheduleIDs(ctx context.Context, accountName, deploymentUID string) (map[string]bool, error) {
+	list, err := r.client.GetBackupSchedules(ctx, accountName, deploymentUID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]bool, len(list.Results))
+	for _, s := range list.Results {
+		ids[s.ID] = true
+	}
+	return ids, nil
+}
+
+// ModifyPlan checks the schedule settings, so an invalid schedule fails at
+// plan time.
+func (r *backupScheduleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan backupScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateBackupSchedule(ctx, plan)...)
+}
+
+// validateBackupSchedule checks the day names, the HH:MM time, and the
+// retention and frequency ranges of plan. Unknown values are skipped.
+func validateBackupSchedule(ctx context.Context, plan backupScheduleResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if !plan.Days.IsUnknown() {
+		var days []types.String
+		diags.Append(plan.Days.ElementsAs(ctx, &days, false)...)
+		if len(days) == 0 && !diags.HasError() {
+			diags.AddAttributeError(path.Root("days"), "Invalid Backup Schedule", "days must name at least one day.")
+		}
+		for i, day := range days {
+			if day.IsUnknown() || validBackupDay(day.ValueString()) {
+				continue
+			}
+			diags.AddAttributeError(path.Root("days").AtListIndex(i), "Invalid Backup Schedule",
+				fmt.Sprintf("%q is not a day name. Use Monday to Sunday or their three-letter abbreviations, such as mon.", day.ValueString()))
+		}
+	}
+	if !plan.Time.IsNull() && !plan.Time.IsUnknown() && !backupScheduleTime.MatchString(plan.Time.ValueString()) {
+		diags.AddAttributeError(path.Root("time"), "Invalid Backup Schedule",
+			fmt.Sprintf("time must be a 24-hour HH:MM time such as 02:30, got %q.", plan.Time.ValueString()))
+	}
+	if v := plan.Retention; !v.IsNull() && !v.IsUnknown() && (v.ValueInt64() < minBackupRetention || v.ValueInt64() > maxBackupRetention) {
+		diags.AddAttributeError(path.Root("retention"), "Invalid Backup Schedule",
+			fmt.Sprintf("retention must be from %d to %d, got %d.", minBackupRetention, maxBackupRetention, v.ValueInt64()))
+	}
+	if v := plan.Frequency; !v.IsNull() && !v.IsUnknown() && (v.ValueInt64() < minBackupFrequency || v.ValueInt64() > maxBackupFrequency) {
+		diags.AddAttributeError(path.Root("frequency"), "Invalid Backup Schedule",
+			fmt.Sprintf("frequency must be from %d to %d hours, got %d.", minBackupFrequency, maxBackupFrequency, v.ValueInt64()))
+	}
+	return diags
+}
+
+// validBackupDay reports whether day is a day name or its three-letter
+// abbreviation.
+func validBackupDay(day string) bool {
+	day = strings.ToLower(day)
+	for _, name := range backupScheduleDays {
+		if day == name || day == name[:3] {
+			return true
+		}
+	}
+	return false
+}
+
 func (r *backupScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan backupScheduleResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -111,7 +234,7 @@ func (r *backupScheduleResource) Create(ctx context.Context, req resource.Create
 		resp.Diagnostics.AddError("Error building backup schedule", err.Error())
 		return
 	}
-	if err := r.client.CreateBackupSchedule(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), body); err != nil {
+	if err := r.client.CreateBackupSchedule(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), body); err != nil {
 		payload, _ := json.Marshal(body)
 		resp.Diagnostics.AddError("Error creating backup schedule", fmt.Sprintf("%s\nrequest body: %s", err.Error(), payload))
 		return
@@ -123,7 +246,7 @@ func (r *backupScheduleResource) Create(ctx context.Context, req resource.Create
 	// The create response does not include the schedule id, so look it up from
 	// the schedule list by matching the attributes we just submitted.
 	scheduleID := ""
-	if id, ok := r.findSchedule(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), days, plan.Retention.ValueInt64(), timeVal); ok {
+	if id, ok := r.findSchedule(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), days, plan.Retention.ValueInt64(), timeVal, nil); ok {
 		scheduleID = id
 	}
 	if scheduleID == "" {
@@ -140,7 +263,7 @@ func (r *backupScheduleResource) Read(ctx context.Context, req resource.ReadRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := r.client.GetBackupSchedules(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	list, err := r.client.GetBackupSchedules(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading backup schedules", err.Error())
 		return
@@ -169,7 +292,7 @@ func (r *backupScheduleResource) Read(ctx context.Context, req resource.ReadRequ
 // an exact id match and falling back to the schedule attributes (the API allows
 // only one schedule per day/time).
 func (r *backupScheduleResource) resolveScheduleID(ctx context.Context, state backupScheduleResourceModel) (string, bool) {
-	list, err := r.client.GetBackupSchedules(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	list, err := r.client.GetBackupSchedules(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		return "", false
 	}
@@ -201,35 +324,139 @@ func (r *backupScheduleResource) Update(ctx context.Context, req resource.Update
 	}
 	accountName := plan.AccountName.ValueString()
 	deploymentUID := plan.DeploymentUID.ValueString()
-	// The backup schedule API has no update endpoint, so apply changes by
-	// deleting the existing schedule and recreating it with the new values.
-	if id, ok := r.resolveScheduleID(ctx, state); ok {
-		if err := r.client.DeleteBackupSchedule(accountName, deploymentUID, id); err != nil {
-			resp.Diagnostics.AddError("Error updating backup schedule", err.Error())
-			return
-		}
-	}
 	body, days, err := r.scheduleBody(ctx, plan)
 	if err != nil {
 		resp.Diagnostics.AddError("Error building backup schedule", err.Error())
 		return
 	}
-	if err := r.client.CreateBackupSchedule(accountName, deploymentUID, body); err != nil {
+
+	// The backup schedule API has no update endpoint. Create the new schedule
+	// before deleting the old one, so the deployment always has a schedule.
+	// The schedules that exist beforehand are listed so the new one can be
+	// told apart from them.
+	oldID, oldExists := r.resolveScheduleID(ctx, state)
+	existing, err := r.scheduleIDs(ctx, accountName, deploymentUID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating backup schedule",
+			"Could not list the deployment's backup schedules before creating the new one: "+err.Error())
+		return
+	}
+	moved := accountName != state.AccountName.ValueString() || deploymentUID != state.DeploymentUID.ValueString()
+	createErr := r.client.CreateBackupSchedule(ctx, accountName, deploymentUID, body)
+	if createErr != nil && oldExists && !moved && scheduleDuplicate(createErr) && sameScheduleSlot(ctx, plan, state) {
+		// The API refuses a second schedule at the same day and time as the
+		// old one: replace the old schedule, restoring it if the new one is
+		// refused as well. Any other rejection leaves the old schedule alone.
+		tflog.Info(ctx, "Backup schedule not created next to the old one; replacing it", map[string]interface{}{
+			"account_name":   accountName,
+			"deployment_uid": deploymentUID,
+			"schedule_id":    oldID,
+			"error":          createErr.Error(),
+		})
+		resp.Diagnostics.Append(r.replaceSchedule(ctx, &state, oldID, body)...)
+		if resp.Diagnostics.HasError() {
+			// Record the ID the old schedule got when it was restored.
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+		oldExists = false
+		createErr = nil
+	}
+	if createErr != nil {
 		payload, _ := json.Marshal(body)
-		resp.Diagnostics.AddError("Error updating backup schedule", fmt.Sprintf("%s\nrequest body: %s", err.Error(), payload))
+		resp.Diagnostics.AddError("Error updating backup schedule", fmt.Sprintf("%s\nrequest body: %s", createErr.Error(), payload))
 		return
 	}
+
 	timeVal := ""
 	if !plan.Time.IsNull() {
 		timeVal = plan.Time.ValueString()
 	}
 	scheduleID := "mock-schedule"
-	if id, ok := r.findSchedule(accountName, deploymentUID, days, plan.Retention.ValueInt64(), timeVal); ok {
+	if id, ok := r.findSchedule(ctx, accountName, deploymentUID, days, plan.Retention.ValueInt64(), timeVal, existing); ok {
+		scheduleID = id
+	} else if id, ok := r.findSchedule(ctx, accountName, deploymentUID, days, plan.Retention.ValueInt64(), timeVal, map[string]bool{oldID: oldExists && !moved}); ok {
 		scheduleID = id
 	}
 	plan.ScheduleID = types.StringValue(scheduleID)
 	plan.ID = types.StringValue(accountName + "/" + deploymentUID + "/" + scheduleID)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if oldExists && (moved || oldID != scheduleID) {
+		if err := r.client.DeleteBackupSchedule(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), oldID); err != nil {
+			resp.Diagnostics.AddError("Error updating backup schedule",
+				fmt.Sprintf("The new schedule %s was created, but the old schedule %s could not be deleted: %s", scheduleID, oldID, err))
+		}
+	}
+}
+
+// backupScheduleDuplicateMessages are fragments of the error the API returns
+// when a deployment already has a schedule at the requested day and time.
+var backupScheduleDuplicateMessages = []string{"already exists", "already scheduled", "duplicate"}
+
+// scheduleDuplicate reports whether err is the API refusing a schedule because
+// the deployment already has one at the same day and time. Other rejections,
+// such as validation errors, are not.
+func scheduleDuplicate(err error) bool {
+	var apiErr *searchstaxClient.APIError
+	if !errors.As(err, &apiErr) || (apiErr.StatusCode != http.StatusBadRequest && apiErr.StatusCode != http.StatusConflict) {
+		return false
+	}
+	body := strings.ToLower(apiErr.Body)
+	for _, message := range backupScheduleDuplicateMessages {
+		if strings.Contains(body, message) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameScheduleSlot reports whether plan keeps the days and time of the
+// schedule in state, so a duplicate error can only come from the old schedule.
+func sameScheduleSlot(ctx context.Context, plan, state backupScheduleResourceModel) bool {
+	var planDays, stateDays []string
+	_ = plan.Days.ElementsAs(ctx, &planDays, false)
+	_ = state.Days.ElementsAs(ctx, &stateDays, false)
+	return reflect.DeepEqual(planDays, stateDays) && normalizeTime(plan.Time.ValueString()) == normalizeTime(state.Time.ValueString())
+}
+
+// replaceSchedule deletes the schedule oldID recorded in state and creates
+// body in its place. When the new schedule is refused, the old one is created
+// again so the deployment keeps a schedule, and its new ID is recorded in
+// state.
+func (r *backupScheduleResource) replaceSchedule(ctx context.Context, state *backupScheduleResourceModel, oldID string, body map[string]any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	accountName := state.AccountName.ValueString()
+	deploymentUID := state.DeploymentUID.ValueString()
+	if err := r.client.DeleteBackupSchedule(ctx, accountName, deploymentUID, oldID); err != nil {
+		diags.AddError("Error updating backup schedule", err.Error())
+		return diags
+	}
+	err := r.client.CreateBackupSchedule(ctx, accountName, deploymentUID, body)
+	if err == nil {
+		return diags
+	}
+	payload, _ := json.Marshal(body)
+	oldBody, oldDays, bodyErr := r.scheduleBody(ctx, *state)
+	if bodyErr == nil {
+		bodyErr = r.client.CreateBackupSchedule(ctx, accountName, deploymentUID, oldBody)
+	}
+	if bodyErr != nil {
+		diags.AddError("Error updating backup schedule",
+			fmt.Sprintf("%s\nrequest body: %s\n\nThe old schedule was deleted and could not be restored, so the deployment "+
+				"has no backup schedule: %s", err.Error(), payload, bodyErr))
+		return diags
+	}
+	if id, ok := r.findSchedule(ctx, accountName, deploymentUID, oldDays, state.Retention.ValueInt64(), state.Time.ValueString(), nil); ok {
+		state.ScheduleID = types.StringValue(id)
+		state.ID = types.StringValue(accountName + "/" + deploymentUID + "/" + id)
+	}
+	diags.AddError("Error updating backup schedule",
+		fmt.Sprintf("%s\nrequest body: %s\n\nThe old schedule was restored.", err.Error(), payload))
+	return diags
 }
 
 func (r *backupScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -240,7 +467,7 @@ func (r *backupScheduleResource) Delete(ctx context.Context, req resource.Delete
 	}
 	accountName := state.AccountName.ValueString()
 	deploymentUID := state.DeploymentUID.ValueString()
-	list, err := r.client.GetBackupSchedules(accountName, deploymentUID)
+	list, err := r.client.GetBackupSchedules(ctx, accountName, deploymentUID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting backup schedule", err.Error())
 		return
@@ -264,7 +491,7 @@ func (r *backupScheduleResource) Delete(ctx context.Context, req resource.Delete
 			timeVal = state.Time.ValueString()
 		}
 		for _, s := range list.Results {
-			if int64(s.Retention) == state.Retention.ValueInt64() && normalizeTime(s.Time) == normalizeTime(timeVal) && reflect.DeepEqual(s.Days, days) {
+			if scheduleMatches(s, days, state.Retention.ValueInt64(), timeVal) {
 				scheduleID = s.ID
 				break
 			}
@@ -274,7 +501,7 @@ func (r *backupScheduleResource) Delete(ctx context.Context, req resource.Delete
 		// No matching schedule remains; treat it as already deleted.
 		return
 	}
-	if err := r.client.DeleteBackupSchedule(accountName, deploymentUID, scheduleID); err != nil {
+	if err := r.client.DeleteBackupSchedule(ctx, accountName, deploymentUID, scheduleID); err != nil {
 		resp.Diagnostics.AddError("Error deleting backup schedule", err.Error())
 	}
 }
@@ -1,8 +1,20 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
 	"testing"
+	"time"
 
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -26,6 +38,167 @@ resource "searchstax_backup_schedule" "test" {
 					resource.TestCheckResourceAttrSet("searchstax_backup_schedule.test", "schedule_id"),
 				),
 			},
+			{
+				Config: providerConfig + `
+resource "searchstax_backup_schedule" "test" {
+  account_name   = "test_account_name"
+  deployment_uid = "ss123456"
+  days           = ["mon", "wed", "fri"]
+  time           = "07:00"
+  retention      = 14
+  region_id      = "us-west-1"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("searchstax_backup_schedule.test", "retention", "14"),
+					resource.TestCheckResourceAttrSet("searchstax_backup_schedule.test", "schedule_id"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "searchstax_backup_schedule" "test" {
+  account_name   = "test_account_name"
+  deployment_uid = "ss123456"
+  days           = ["mon", "someday"]
+  time           = "7pm"
+  retention      = 14
+  region_id      = "us-west-1"
+}`,
+				ExpectError: regexp.MustCompile(`Invalid Backup Schedule`),
+			},
 		},
 	})
 }
+
+// scheduleAPI fakes the backup schedule endpoints of one deployment. Creates
+// are answered with the queued responses in turn; a successful create adds a
+// schedule with the next ID. With listError, listing the schedules fails.
+type scheduleAPI struct {
+	schedules []map[string]any
+	nextID    int
+	creates   []string
+	deletes   int
+	listError bool
+}
+
+func (a *scheduleAPI) handle(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		if a.listError {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"detail": "You do not have permission to perform this action."}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(a.schedules)
+	case http.MethodDelete:
+		a.deletes++
+		a.schedules = nil
+		_, _ = w.Write([]byte(`{"success": "The backup schedule has been deleted!"}`))
+	case http.MethodPost:
+		response := a.creates[0]
+		a.creates = a.creates[1:]
+		if response != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(response))
+			return
+		}
+		var schedule map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&schedule)
+		schedule["id"] = a.nextID
+		a.nextID++
+		a.schedules = append(a.schedules, schedule)
+		_, _ = w.Write([]byte(`{"message": "Backup Scheduled Successfully"}`))
+	}
+}
+
+func TestBackupScheduleUpdateRejected(t *testing.T) {
+	ctx := context.Background()
+	oldSchedule := map[string]any{"id": 11, "days": []string{"mon"}, "time": "07:00", "retention": 7, "region_id": "us-west-1"}
+	tests := []struct {
+		name        string
+		creates     []string
+		listError   bool
+		wantDeletes int
+		wantID      string
+	}{
+		{
+			name:        "validation error keeps the old schedule",
+			creates:     []string{`{"retention": ["Ensure this value is less than or equal to 365."]}`},
+			wantDeletes: 0,
+			wantID:      "11",
+		},
+		{
+			name:        "duplicate then refused restores the old schedule",
+			creates:     []string{`{"message": "Backup schedule already exists"}`, `{"message": "Invalid retention"}`, ""},
+			wantDeletes: 1,
+			wantID:      "12",
+		},
+		{
+			name:        "schedules cannot be listed",
+			listError:   true,
+			wantDeletes: 0,
+			wantID:      "11",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &scheduleAPI{schedules: []map[string]any{oldSchedule}, nextID: 12, creates: tt.creates, listError: tt.listError}
+			server := httptest.NewServer(http.HandlerFunc(api.handle))
+			defer server.Close()
+			host := server.URL
+			client, err := searchstaxClient.NewClient(ctx, &host, nil, nil, searchstaxClient.WithToken("token"), searchstaxClient.WithRetry(0, time.Millisecond))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := &backupScheduleResource{client: client}
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+			state := backupScheduleResourceModel{
+				ID:            types.StringValue("acct/ss1/11"),
+				AccountName:   types.StringValue("acct"),
+				DeploymentUID: types.StringValue("ss1"),
+				ScheduleID:    types.StringValue("11"),
+				Days:          types.ListValueMust(types.StringType, []attr.Value{types.StringValue("mon")}),
+				Retention:     types.Int64Value(7),
+				RegionID:      types.StringValue("us-west-1"),
+				Time:          types.StringValue("07:00"),
+				Frequency:     types.Int64Null(),
+				Collections:   types.ListNull(types.StringType),
+			}
+			plan := state
+			plan.Retention = types.Int64Value(400)
+			plan.ScheduleID = types.StringUnknown()
+			plan.ID = types.StringUnknown()
+
+			req := fwresource.UpdateRequest{
+				Plan:  tfsdk.Plan{Schema: schemaResp.Schema},
+				State: tfsdk.State{Schema: schemaResp.Schema},
+			}
+			if diags := req.Plan.Set(ctx, plan); diags.HasError() {
+				t.Fatalf("setting plan: %v", diags)
+			}
+			if diags := req.State.Set(ctx, state); diags.HasError() {
+				t.Fatalf("setting state: %v", diags)
+			}
+			resp := fwresource.UpdateResponse{State: req.State}
+			r.Update(ctx, req, &resp)
+
+			if !resp.Diagnostics.HasError() {
+				t.Fatal("expected the update to fail")
+			}
+			if api.deletes != tt.wantDeletes {
+				t.Errorf("deleted %d schedules, want %d", api.deletes, tt.wantDeletes)
+			}
+			if len(api.schedules) != 1 {
+				t.Errorf("deployment has %d schedules, want 1", len(api.schedules))
+			}
+			var got backupScheduleResourceModel
+			if diags := resp.State.Get(ctx, &got); diags.HasError() {
+				t.Fatalf("reading state: %v", diags)
+			}
+			if got.ScheduleID.ValueString() != tt.wantID {
+				t.Errorf("schedule_id in state = %q, want %q", got.ScheduleID.ValueString(), tt.wantID)
+			}
+		})
+	}
+}
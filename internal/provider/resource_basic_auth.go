@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	searchstaxClient "terraform-provider-searchstax/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -13,6 +15,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// defaultBasicAuthTimeout bounds enabling or disabling basic auth, which
+// restarts Solr and is retried while the cluster settles.
+const defaultBasicAuthTimeout = 20 * time.Minute
+
 func NewBasicAuthResource() resource.Resource { return &basicAuthResource{} }
 
 type basicAuthResource struct{ client *searchstaxClient.Client }
@@ -21,18 +27,27 @@ func (r *basicAuthResource) Metadata(_ context.Context, req resource.MetadataReq
 	resp.TypeName = req.ProviderTypeName + "_basic_auth"
 }
 
-func (r *basicAuthResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{Attributes: map[string]schema.Attribute{
-		"id":             schema.StringAttribute{Computed: true},
-		"account_name":   schema.StringAttribute{Required: true},
-		"deployment_uid": schema.StringAttribute{Required: true},
-		"enabled": schema.BoolAttribute{
-			Required: true,
-			PlanModifiers: []planmodifier.Bool{
-				boolplanmodifier.RequiresReplace(),
+func (r *basicAuthResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":             schema.StringAttribute{Computed: true},
+			"account_name":   schema.StringAttribute{Required: true},
+			"deployment_uid": schema.StringAttribute{Required: true},
+			"enabled": schema.BoolAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
 			},
 		},
-	}}
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
 }
 
 func (r *basicAuthResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -47,12 +62,12 @@ func (r *basicAuthResource) Configure(_ context.Context, req resource.ConfigureR
 	r.client = c
 }
 
-func (r *basicAuthResource) setEnabled(accountName, deploymentUID string, enabled bool) error {
+func (r *basicAuthResource) setEnabled(ctx context.Context, accountName, deploymentUID string, enabled bool) error {
 	if enabled {
-		_, err := r.client.EnableBasicAuth(accountName, deploymentUID)
+		_, err := r.client.EnableBasicAuth(ctx, accountName, deploymentUID)
 		return err
 	}
-	_, err := r.client.DisableBasicAuth(accountName, deploymentUID)
+	_, err := r.client.DisableBasicAuth(ctx, accountName, deploymentUID)
 	return err
 }
 
@@ -62,7 +77,19 @@ func (r *basicAuthResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.setEnabled(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), plan.Enabled.ValueBool()); err != nil {
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultBasicAuthTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if err := r.setEnabled(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), plan.Enabled.ValueBool()); err != nil {
+		if timeoutDiag, ok := timeoutDiagnostic(err, "create"); ok {
+			resp.Diagnostics.Append(timeoutDiag)
+			return
+		}
 		resp.Diagnostics.AddError("Error configuring basic auth", err.Error())
 		return
 	}
@@ -76,7 +103,7 @@ func (r *basicAuthResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	enabled, err := r.client.IsBasicAuthEnabled(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	enabled, err := r.client.IsBasicAuthEnabled(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading basic auth status", err.Error())
 		return
@@ -92,7 +119,19 @@ func (r *basicAuthResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.setEnabled(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), plan.Enabled.ValueBool()); err != nil {
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultBasicAuthTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if err := r.setEnabled(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), plan.Enabled.ValueBool()); err != nil {
+		if timeoutDiag, ok := timeoutDiagnostic(err, "update"); ok {
+			resp.Diagnostics.Append(timeoutDiag)
+			return
+		}
 		resp.Diagnostics.AddError("Error updating basic auth", err.Error())
 		return
 	}
@@ -106,16 +145,25 @@ func (r *basicAuthResource) Delete(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultBasicAuthTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	if state.Enabled.ValueBool() {
-		if _, err := r.client.DisableBasicAuth(state.AccountName.ValueString(), state.DeploymentUID.ValueString()); err != nil {
+		if _, err := r.client.DisableBasicAuth(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString()); err != nil {
 			resp.Diagnostics.AddError("Error disabling basic auth", err.Error())
 		}
 	}
 }
 
 type basicAuthResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	AccountName   types.String `tfsdk:"account_name"`
-	DeploymentUID types.String `tfsdk:"deployment_uid"`
-	Enabled       types.Bool   `tfsdk:"enabled"`
+	ID            types.String   `tfsdk:"id"`
+	AccountName   types.String   `tfsdk:"account_name"`
+	DeploymentUID types.String   `tfsdk:"deployment_uid"`
+	Enabled       types.Bool     `tfsdk:"enabled"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
@@ -16,9 +16,14 @@ resource "searchstax_basic_auth" "test" {
   account_name   = "test_account_name"
   deployment_uid = "ss123456"
   enabled        = true
+
+  timeouts {
+    create = "5m"
+  }
 }`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("searchstax_basic_auth.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("searchstax_basic_auth.test", "timeouts.create", "5m"),
 				),
 			},
 		},
@@ -47,7 +47,7 @@ func (r *customJarResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.UploadCustomJar(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.CustomJar{Name: plan.Name.ValueString(), FilePath: plan.FilePath.ValueString(), SourceURL: plan.SourceURL.ValueString()}); err != nil {
+	if err := r.client.UploadCustomJar(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.CustomJar{Name: plan.Name.ValueString(), FilePath: plan.FilePath.ValueString(), SourceURL: plan.SourceURL.ValueString()}); err != nil {
 		resp.Diagnostics.AddError("Error uploading custom jar", err.Error())
 		return
 	}
@@ -60,7 +60,7 @@ func (r *customJarResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := r.client.GetCustomJars(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	list, err := r.client.GetCustomJars(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading custom jars", err.Error())
 		return
@@ -83,7 +83,7 @@ func (r *customJarResource) Delete(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.DeleteCustomJar(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Name.ValueString()); err != nil {
+	if err := r.client.DeleteCustomJar(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Name.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting custom jar", err.Error())
 	}
 }
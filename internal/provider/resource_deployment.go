@@ -3,9 +3,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
+
 	searchstaxClient "terraform-provider-searchstax/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,11 +20,61 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.ResourceWithImportState = &deploymentResource{}
+	_ resource.ResourceWithModifyPlan  = &deploymentResource{}
+)
+
+// deploymentRequestAttributes are the attributes sent when creating a
+// deployment; API field errors on them are reported against the attribute.
+var deploymentRequestAttributes = []string{"name", "application", "application_version", "plan_type", "plan", "region_id", "cloud_provider_id", "termination_lock", "private_vpc", "num_additional_app_nodes"}
+
+// waitForDeploymentReady reports whether applying plan waits for the
+// deployment to be provisioned; wait_for_ready defaults to true.
+func waitForDeploymentReady(plan deploymentModel) bool {
+	return plan.WaitForReady.IsNull() || plan.WaitForReady.ValueBool()
+}
+
+// deploymentProvisioning reports whether the deployment in state has not
+// finished provisioning yet, because its create returned before it was ready.
+func deploymentProvisioning(state deploymentModel) bool {
+	provisionState := state.ProvisionState.ValueString()
+	return provisionState != "" && provisionState != "Done" && state.Status.ValueString() != "Failed"
+}
+
+// deploymentReplacedAttributes returns the replacement-forcing attributes
+// (those with a RequiresReplace plan modifier) that differ between plan and
+// state.
+func deploymentReplacedAttributes(plan, state deploymentModel) path.Paths {
+	attributes := map[string][2]types.String{
+		"account_name":        {plan.AccountName, state.AccountName},
+		"name":                {plan.Name, state.Name},
+		"application":         {plan.Application, state.Application},
+		"application_version": {plan.ApplicationVersion, state.ApplicationVersion},
+		"plan_type":           {plan.PlanType, state.PlanType},
+		"region_id":           {plan.RegionId, state.RegionId},
+		"cloud_provider_id":   {plan.CloudProviderId, state.CloudProviderId},
+	}
+	var replaced path.Paths
+	for attr, values := range attributes {
+		if !values[0].Equal(values[1]) {
+			replaced = append(replaced, path.Root(attr))
+		}
+	}
+	sort.Slice(replaced, func(i, j int) bool { return replaced[i].String() < replaced[j].String() })
+	return replaced
+}
+
+// Default waits for deployment operations; override them per resource with a
+// timeouts block.
+const (
+	defaultDeploymentCreateTimeout = 90 * time.Minute
+	defaultDeploymentUpdateTimeout = 90 * time.Minute
+	defaultDeploymentDeleteTimeout = 30 * time.Minute
 )
 
 // NewDeploymentResource is a helper function to simplify the provider implementation.
@@ -58,19 +113,31 @@ func (d *deploymentResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 // Schema defines the schema for the resource.
-func (d *deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (d *deploymentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a SearchStax Solr deployment (cluster).\n\n" +
-			"~> **Changing an existing deployment.** The SearchStax Provisioning API does not expose " +
-			"an endpoint to update deployment settings in place. Core attributes (`plan`, `region_id`, " +
-			"`application_version`, `cloud_provider_id`, `account_name`, ...) are therefore " +
-			"replacement-forcing: changing them destroys and recreates the cluster and all of its data. " +
-			"Plan carefully before applying such a change.\n\n" +
+			"~> **Changing an existing deployment.** `plan`, `num_additional_app_nodes` and " +
+			"`num_additional_zookeeper_nodes` are changed in place through the SearchStax scaling API, " +
+			"keeping the deployment's data; the update waits until `desired_tier` has become the " +
+			"current `tier`. The other core attributes (`account_name`, `name`, `application`, " +
+			"`application_version`, `plan_type`, `region_id`, `cloud_provider_id`) are " +
+			"replacement-forcing: changing them destroys and recreates the cluster and all of its data, " +
+			"so such plans fail unless `allow_replacement = true` is set.\n\n" +
+			"`plan_type`, `plan`, `application_version`, `region_id` and `cloud_provider_id` are checked " +
+			"against the account's plan catalog (see the `searchstax_plans` data source) when planning, so an " +
+			"invalid value fails before anything is created and the error lists the valid choices.\n\n" +
 			"~> **`termination_lock` cannot be toggled through this provider.** It is a Dashboard-only " +
 			"control in the SearchStax console. If the configured value differs from the deployment's " +
 			"actual state, `terraform plan` will keep showing a diff that never converges. To resolve it, " +
 			"change the Termination Lock in the SearchStax Dashboard and set `termination_lock` in your " +
-			"configuration to the same value.",
+			"configuration to the same value.\n\n" +
+			"Creating, updating and deleting a deployment wait for SearchStax to finish the operation. " +
+			"With a `source` block the create wait also covers restoring the backup. The waits default to 90, " +
+			"90 and 30 minutes and can be changed with a `timeouts` block. " +
+			"The deployment's `uid` is saved to state as soon as SearchStax accepts the create request: if the " +
+			"create wait times out or is interrupted, the deployment stays in state while it provisions and the " +
+			"next apply resumes waiting instead of creating a second cluster. Set `wait_for_ready = false` to " +
+			"return without waiting.",
 		Attributes: map[string]schema.Attribute{
 			// id is required by the testing framework
 			"id": schema.StringAttribute{
@@ -90,6 +157,9 @@ func (d *deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"application": schema.StringAttribute{
 				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"application_version": schema.StringAttribute{
 				Required: true,
@@ -111,9 +181,8 @@ func (d *deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"plan": schema.StringAttribute{
 				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				MarkdownDescription: "The deployment plan (tier), such as `NDC4-GCP-G`. Changing it resizes the " +
+					"deployment in place within its `plan_type`.",
 			},
 			"cloud_provider_id": schema.StringAttribute{
 				Required: true,
@@ -128,6 +197,33 @@ func (d *deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					"provider.** Set it to match the deployment's actual state to avoid a perpetual plan diff; " +
 					"a change here is not pushed to SearchStax.",
 			},
+			"allow_replacement": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Set to `true` to let Terraform replace this deployment (destroy it, with all of its data, " +
+					"and create a new one) when a replacement-forcing attribute changes, or destroy it while the provider's " +
+					"`deletion_protection` is on. Otherwise such plans fail. It is not sent to SearchStax.",
+			},
+			"adopt_existing": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "When `true`, create first looks for an existing deployment with the same `name`. One " +
+					"that also has the same application, version, plan type, plan, region and cloud provider is adopted " +
+					"into state instead of creating a new cluster, which makes a retried apply safe; one with a different " +
+					"spec fails the create with a conflict naming its UID. Failed deployments and ones being deleted are " +
+					"ignored. It cannot be combined with a `source` block. " +
+					"Defaults to `false`, which always creates a new deployment (the API allows duplicate names).",
+			},
+			"backup_on_destroy": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "When `true`, destroying the deployment first creates a backup of it and waits for the " +
+					"backup to complete; the backup ID is reported in a warning and in the logs. If the backup fails or " +
+					"does not complete within the delete timeout, the deployment is not destroyed. Defaults to `false`.",
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Whether applying waits until the deployment is provisioned and running. Defaults to " +
+					"`true`. With `false`, create returns as soon as SearchStax has assigned the deployment's `uid`, and a " +
+					"later apply with `true` waits for a deployment that is still provisioning.",
+			},
 			"private_vpc": schema.Int64Attribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.Int64{
@@ -190,6 +286,8 @@ func (d *deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"num_additional_app_nodes": schema.Int64Attribute{
 				Optional: true,
+				MarkdownDescription: "Number of Solr nodes added to the plan's default node count. " +
+					"Changing it scales the deployment in place.",
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
@@ -224,7 +322,10 @@ func (d *deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"num_additional_zookeeper_nodes": schema.Int64Attribute{
+				Optional: true,
 				Computed: true,
+				MarkdownDescription: "Number of ZooKeeper nodes added to the plan's default ensemble. " +
+					"Changing it scales the deployment in place; when unset, the current count is kept.",
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
@@ -310,6 +411,41 @@ func (d *deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"source": schema.SingleNestedBlock{
+				MarkdownDescription: "Creates the deployment as a clone: once it is provisioned, a backup is restored into " +
+					"it, and the deployment is only reported as created when the restore has finished. Provisioning, the " +
+					"backup of `deployment_uid` and the restore, account backups included, all share the create timeout " +
+					"(90 minutes unless set in `timeouts`), so raise it for large indexes. A failed or timed-out restore " +
+					"leaves the deployment tainted. The block is only " +
+					"used on create; changing or removing it later does not affect the deployment.",
+				Attributes: map[string]schema.Attribute{
+					"deployment_uid": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "UID of a deployment to clone. A new backup of it is taken and restored. " +
+							"Conflicts with `backup_id`.",
+					},
+					"backup_id": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "ID of an existing deployment or account backup to restore. An account " +
+							"backup is restored through the account restore endpoint, any other through the deployment " +
+							"one. Conflicts with `deployment_uid`.",
+					},
+					"restored_backup_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the backup that was restored into the deployment.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -337,13 +473,100 @@ func (d *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		PrivateVpc:            plan.PrivateVpc.ValueInt64(),
 	}
 
-	// Create new deployment
-	var deployment, err = d.client.CreateDeployment(item, plan.AccountName.ValueString())
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultDeploymentCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Adopt a matching deployment when adopt_existing is set, or request a
+	// new one. Its UID is saved to state right away, so a create that is
+	// interrupted while provisioning is resumed by the next apply instead of
+	// creating a duplicate cluster.
+	var deployment *searchstaxClient.Deployment
+	if plan.AdoptExisting.ValueBool() {
+		existing, err := d.client.GetDeployments(ctx, plan.AccountName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating deployment",
+				"Could not list deployments to look for one to adopt: "+err.Error(),
+			)
+			return
+		}
+		deployment, diags = findAdoptableDeployment(existing.Results, item)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if deployment != nil {
+			tflog.Info(ctx, "Adopting existing SearchStax deployment", map[string]interface{}{
+				"account_name":   plan.AccountName.ValueString(),
+				"deployment_uid": deployment.UID,
+				"name":           deployment.Name,
+			})
+		}
+	}
+	if deployment == nil {
+		var err error
+		deployment, err = d.client.StartDeployment(ctx, item, plan.AccountName.ValueString())
+		if err != nil {
+			resp.Diagnostics.Append(apiErrorDiagnostics(
+				"Error creating deployment",
+				"Could not create deployment",
+				err, deploymentRequestAttributes...,
+			)...)
+			return
+		}
+	}
+	// The create API has no ZooKeeper node count, and an adopted deployment
+	// may have other node counts than configured; the configured nodes are
+	// set by scaling the deployment once it is ready. Until then state
+	// records the configured counts, and a refresh shows the actual ones.
+	configured := plan
+	if plan.Source != nil {
+		plan.Source.RestoredID = types.StringNull()
+	}
+	plan.ID = types.StringValue("placeholder")
+	resp.Diagnostics.Append(populateDeploymentResourceModel(ctx, &plan, *deployment)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !configured.NumAdditionalAppNodes.IsNull() && !configured.NumAdditionalAppNodes.IsUnknown() {
+		plan.NumAdditionalAppNodes = configured.NumAdditionalAppNodes
+	}
+	if !configured.NumAdditionalZookeeperNodes.IsNull() && !configured.NumAdditionalZookeeperNodes.IsUnknown() {
+		plan.NumAdditionalZookeeperNodes = configured.NumAdditionalZookeeperNodes
+	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !waitForDeploymentReady(plan) {
+		return
+	}
+
+	deployment, err := d.client.WaitForDeploymentReady(ctx, plan.AccountName.ValueString(), deployment)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if timeoutDiag, ok := timeoutDiagnostic(err, "create"); ok && plan.Source == nil {
+			// Leave the deployment in state untainted; the next apply
+			// resumes the wait.
+			resp.Diagnostics.AddWarning(
+				"SearchStax Deployment Still Provisioning",
+				fmt.Sprintf("%s\n\nDeployment %s has been saved to state and SearchStax keeps provisioning it; "+
+					"the next apply resumes waiting for it to become ready.", timeoutDiag.Detail(), plan.UID.ValueString()),
+			)
+			return
+		} else if ok {
+			// A clone is not resumed, since the backup still has to be
+			// restored into it: fail and leave it tainted.
+			resp.Diagnostics.Append(timeoutDiag)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics(
 			"Error creating deployment",
-			"Could not create deployment, unexpected error: "+err.Error(),
-		)
+			"Deployment "+plan.UID.ValueString()+" failed to provision",
+			err,
+		)...)
 		return
 	}
 
@@ -354,12 +577,48 @@ func (d *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	// Restore the source backup into a cloned deployment before reporting it
+	// as created. A failed restore leaves the deployment in state tainted.
+	if plan.Source != nil {
+		resp.Diagnostics.Append(d.restoreDeploymentSource(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Set state to fully populated data
+	resize := int64Changed(configured.NumAdditionalAppNodes, plan.NumAdditionalAppNodes) ||
+		int64Changed(configured.NumAdditionalZookeeperNodes, plan.NumAdditionalZookeeperNodes)
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !resize {
+		return
+	}
+
+	// The deployment is already in state, so a failure from here on leaves it
+	// tainted rather than orphaned.
+	// The plan tier was requested on create or matched on adoption.
+	scale := deploymentScaleRequest(configured, plan)
+	scale.Plan = ""
+	deployment, err = d.client.ScaleDeployment(ctx, plan.AccountName.ValueString(), plan.UID.ValueString(), scale)
+	if err != nil {
+		if timeoutDiag, ok := timeoutDiagnostic(err, "create"); ok {
+			resp.Diagnostics.Append(timeoutDiag)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics(
+			"Error creating deployment",
+			"Could not set the configured node counts of deployment "+plan.UID.ValueString(),
+			err, deploymentScaleAttributes...,
+		)...)
+		return
+	}
+	resp.Diagnostics.Append(populateDeploymentResourceModel(ctx, &plan, *deployment)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read resource information.
@@ -373,7 +632,7 @@ func (d *deploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get refreshed deployment value from SearchStax
-	var deployment, err = d.client.GetDeployment(state.AccountName.ValueString(), state.UID.ValueString())
+	var deployment, err = d.client.GetDeployment(ctx, state.AccountName.ValueString(), state.UID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading SearchStax deployment",
@@ -397,7 +656,10 @@ func (d *deploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 }
 
-// Update updates the resource and sets the updated Terraform state on success.
+// Update resizes the deployment in place and sets the updated Terraform state
+// on success. Only the plan tier and node counts can change without replacing
+// the deployment; every other configurable attribute forces replacement or is
+// not pushed to SearchStax.
 func (d *deploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
 	var plan deploymentModel
@@ -407,64 +669,188 @@ func (d *deploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	// if we're changing only the PrivateVPC, then update the state and return
-	// this is a WORKAROUND until the API returns a private_vpc id as well
-	if plan.PrivateVpc.ValueInt64() != 0 {
+	var state deploymentModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The source block is only used on create; one added later restores
+	// nothing.
+	if plan.Source != nil && plan.Source.RestoredID.IsUnknown() {
+		plan.Source.RestoredID = types.StringNull()
+	}
+
+	// Nothing to resize or wait for (only termination_lock, private_vpc,
+	// wait_for_ready or timeouts changed): keep the planned values, which
+	// carry the unchanged computed attributes. private_vpc is not returned by
+	// the API, so it is only recorded in state.
+	resuming := waitForDeploymentReady(plan) && deploymentProvisioning(state)
+	if !resuming && !deploymentScaleChanged(plan, state) {
 		plan.ID = types.StringValue("placeholder")
 		diags = resp.State.Set(ctx, plan)
 		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDeploymentUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Resume waiting for a deployment whose create did not see it become ready
+	plan.ID = types.StringValue("placeholder")
+	if resuming {
+		deployment, err := d.client.WaitForDeploymentReady(ctx, state.AccountName.ValueString(), &searchstaxClient.Deployment{UID: state.UID.ValueString()})
+		if err != nil {
+			if timeoutDiag, ok := timeoutDiagnostic(err, "update"); ok {
+				resp.Diagnostics.Append(timeoutDiag)
+				return
+			}
+			resp.Diagnostics.Append(apiErrorDiagnostics(
+				"Error Updating SearchStax Deployment",
+				"Deployment "+state.UID.ValueString()+" failed to provision",
+				err,
+			)...)
+			return
+		}
+		plan.Status = types.StringValue(deployment.Status)
+		plan.ProvisionState = types.StringValue(deployment.ProvisionState)
+		plan.HttpEndpoint = types.StringValue(deployment.HttpEndpoint)
+	}
+
+	// Resize the existing deployment, keeping its data
+	if deploymentScaleChanged(plan, state) {
+		deployment, err := d.client.ScaleDeployment(ctx, state.AccountName.ValueString(), state.UID.ValueString(), deploymentScaleRequest(plan, state))
+		if err != nil {
+			if timeoutDiag, ok := timeoutDiagnostic(err, "update"); ok {
+				resp.Diagnostics.Append(timeoutDiag)
+				return
+			}
+			resp.Diagnostics.Append(apiErrorDiagnostics(
+				"Error Updating SearchStax Deployment",
+				"Could not scale Deployment",
+				err, deploymentScaleAttributes...,
+			)...)
+			return
+		}
+
+		// Overwrite items with refreshed state
+		resp.Diagnostics.Append(populateDeploymentResourceModel(ctx, &plan, *deployment)...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+}
 
-	// Generate API request body from plan
-	var item = searchstaxClient.Deployment{
-		UID:                   plan.UID.ValueString(),
-		Name:                  plan.Name.ValueString(),
-		Application:           plan.Application.ValueString(),
-		ApplicationVersion:    plan.ApplicationVersion.ValueString(),
-		TerminationLock:       plan.TerminationLock.ValueBool(),
-		PlanType:              plan.PlanType.ValueString(),
-		Plan:                  plan.Plan.ValueString(),
-		RegionId:              plan.RegionId.ValueString(),
-		CloudProviderId:       plan.CloudProviderId.ValueString(),
-		NumAdditionalAppNodes: plan.NumAdditionalAppNodes.ValueInt64(),
-		NumNodesDefault:       plan.NumNodesDefault.ValueInt64(),
-		PrivateVpc:            plan.PrivateVpc.ValueInt64(),
-		HttpEndpoint:          plan.HttpEndpoint.ValueString(),
-		Status:                plan.Status.ValueString(),
-		ProvisionState:        plan.ProvisionState.ValueString(),
-		Tier:                  plan.Tier.ValueString(),
-		DateCreated:           plan.DateCreated.ValueString(),
-		DeploymentType:        plan.DeploymentType.ValueString(),
-	}
-
-	// Update existing deployment (recreate the cluster)
-	uid := ""
-	req.State.GetAttribute(ctx, path.Root("uid"), &uid)
-	deployment, err := d.client.UpdateDeployment(plan.AccountName.ValueString(), uid, item)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating SearchStax Deployment",
-			"Could not update Deployment, unexpected error: "+err.Error(),
-		)
+// ModifyPlan checks a new or changed deployment against the SearchStax plan
+// catalog, so an invalid plan, region or version fails at plan time rather
+// than minutes into the apply. It also guards the deployment against
+// accidental destruction: a planned replacement fails unless
+// allow_replacement is true, and so does a destroy while the provider's
+// deletion_protection is on. When the plan resizes the deployment, the
+// size-dependent computed attributes are shown as unknown; SearchStax reports
+// them once scaling is done.
+func (d *deploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state deploymentModel
+	creating := req.State.Raw.IsNull()
+	if !creating {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if req.Plan.Raw.IsNull() {
+		if d.client != nil && d.client.DeletionProtection && !state.AllowReplacement.ValueBool() {
+			resp.Diagnostics.AddError(
+				"Deployment Deletion Not Allowed",
+				fmt.Sprintf("The provider's deletion_protection is on, so deployment %s (%s) cannot be destroyed. "+
+					"If destroying it and all of its data is intended, first apply allow_replacement = true on the "+
+					"resource, then destroy it.", state.UID.ValueString(), state.Name.ValueString()),
+			)
+		}
 		return
 	}
 
-	// Overwrite items with refreshed state
-	plan.ID = types.StringValue("placeholder")
-	resp.Diagnostics.Append(populateDeploymentResourceModel(ctx, &plan, *deployment)...)
+	var plan deploymentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if creating {
+		resp.Diagnostics.Append(validateDeploymentSource(plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if (creating || deploymentCatalogChanged(plan, state)) && deploymentCatalogKnown(plan) {
+		resp.Diagnostics.Append(d.validateCatalog(ctx, plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if creating {
+		return
+	}
+
+	if replaced := deploymentReplacedAttributes(plan, state); len(replaced) > 0 && !plan.AllowReplacement.ValueBool() {
+		for _, attr := range replaced {
+			resp.Diagnostics.AddAttributeError(
+				attr,
+				"Deployment Replacement Not Allowed",
+				fmt.Sprintf("Changing %s cannot be done in place: it would destroy deployment %s (%s) with all of its "+
+					"data and create a new one. Revert the change, or set allow_replacement = true if the replacement "+
+					"is intended.", attr, state.UID.ValueString(), state.Name.ValueString()),
+			)
+		}
+		return
+	}
+
+	resuming := waitForDeploymentReady(plan) && deploymentProvisioning(state)
+	if !resuming && !deploymentScaleChanged(plan, state) {
 		return
 	}
+	if resuming {
+		plan.Status = types.StringUnknown()
+		plan.ProvisionState = types.StringUnknown()
+		plan.HttpEndpoint = types.StringUnknown()
+	}
+	if deploymentScaleChanged(plan, state) {
+		markDeploymentSizeUnknown(&plan)
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// validateCatalog checks plan against the plans the account can deploy for
+// its application. A catalog that cannot be read only produces a warning; the
+// API still validates the request on apply.
+func (d *deploymentResource) validateCatalog(ctx context.Context, plan deploymentModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if d.client == nil || plan.AccountName.IsUnknown() || plan.Application.IsUnknown() {
+		return diags
+	}
+	plans, err := d.client.GetAllPlans(ctx, plan.AccountName.ValueString(), plan.Application.ValueString(), "")
+	if err != nil {
+		diags.AddWarning(
+			"Could Not Validate SearchStax Deployment",
+			"Could not read the SearchStax plan catalog to check plan, plan_type, region_id, cloud_provider_id "+
+				"and application_version; they will be checked by the API on apply: "+err.Error(),
+		)
+		return diags
+	}
+	return validateDeploymentCatalog(plan, plans.Results)
 }
 
 func (d *deploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -476,9 +862,30 @@ func (d *deploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeploymentDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Back the deployment up first when asked to; a failed backup aborts
+	// the destroy.
+	if state.BackupOnDestroy.ValueBool() {
+		resp.Diagnostics.Append(d.backupBeforeDestroy(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Delete existing deployment
 	err := d.client.DeleteDeployment(ctx, state.AccountName.ValueString(), state.UID.ValueString())
 	if err != nil {
+		if timeoutDiag, ok := timeoutDiagnostic(err, "delete"); ok {
+			resp.Diagnostics.Append(timeoutDiag)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting SearchStax Deployment",
 			"Could not delete deployment, unexpected error: "+err.Error(),
@@ -487,6 +894,48 @@ func (d *deploymentResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 }
 
+// backupBeforeDestroy creates a backup of the deployment in state and waits
+// for it to complete. The backup ID is logged and reported in a warning, so it
+// can be restored later.
+func (d *deploymentResource) backupBeforeDestroy(ctx context.Context, state deploymentModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	accountName, uid := state.AccountName.ValueString(), state.UID.ValueString()
+
+	tflog.Info(ctx, "Backing up SearchStax deployment before destroying it", map[string]interface{}{
+		"account_name":   accountName,
+		"deployment_uid": uid,
+	})
+	backup, err := d.client.CreateDeploymentBackup(ctx, accountName, uid, map[string]any{})
+	if err != nil {
+		diags.Append(apiErrorDiagnostics(
+			"Error Backing Up SearchStax Deployment",
+			"Could not back up deployment "+uid+" before destroying it, so it was not destroyed",
+			err,
+		)...)
+		return diags
+	}
+	if err := d.client.WaitForDeploymentBackup(ctx, accountName, uid, backup.BackupID); err != nil {
+		diags.AddError(
+			"Error Backing Up SearchStax Deployment",
+			fmt.Sprintf("Backup %s of deployment %s did not complete, so the deployment was not destroyed: %s",
+				backup.BackupID, uid, err),
+		)
+		return diags
+	}
+
+	tflog.Info(ctx, "Backed up SearchStax deployment before destroying it", map[string]interface{}{
+		"account_name":   accountName,
+		"deployment_uid": uid,
+		"backup_id":      backup.BackupID,
+	})
+	diags.AddWarning(
+		"SearchStax Deployment Backed Up Before Destroy",
+		fmt.Sprintf("Deployment %s (%s) was backed up as backup %s before being destroyed. Restore it with "+
+			"searchstax_restore or from the SearchStax Dashboard.", uid, state.Name.ValueString(), backup.BackupID),
+	)
+	return diags
+}
+
 // ImportState - Import existing deployment cluster into terraform state.
 func (d *deploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, "/")
@@ -505,43 +954,49 @@ func (d *deploymentResource) ImportState(ctx context.Context, req resource.Impor
 
 // deploymentModel maps deployment schema data.
 type deploymentModel struct {
-	ID                          types.String `tfsdk:"id"`
-	AccountName                 types.String `tfsdk:"account_name"`
-	UID                         types.String `tfsdk:"uid"`
-	Name                        types.String `tfsdk:"name"`
-	Application                 types.String `tfsdk:"application"`
-	ApplicationVersion          types.String `tfsdk:"application_version"`
-	TerminationLock             types.Bool   `tfsdk:"termination_lock"`
-	PlanType                    types.String `tfsdk:"plan_type"`
-	Plan                        types.String `tfsdk:"plan"`
-	RegionId                    types.String `tfsdk:"region_id"`
-	CloudProvider               types.String `tfsdk:"cloud_provider"`
-	CloudProviderId             types.String `tfsdk:"cloud_provider_id"`
-	NumAdditionalAppNodes       types.Int64  `tfsdk:"num_additional_app_nodes"`
-	PrivateVpc                  types.Int64  `tfsdk:"private_vpc"`
-	Tier                        types.String `tfsdk:"tier"`
-	HttpEndpoint                types.String `tfsdk:"http_endpoint"`
-	ProvisionState              types.String `tfsdk:"provision_state"`
-	Status                      types.String `tfsdk:"status"`
-	DateCreated                 types.String `tfsdk:"date_created"`
-	IsMasterSlave               types.Bool   `tfsdk:"is_master_slave"`
-	VpcType                     types.String `tfsdk:"vpc_type"`
-	VpcName                     types.String `tfsdk:"vpc_name"`
-	DeploymentType              types.String `tfsdk:"deployment_type"`
-	NumNodesDefault             types.Int64  `tfsdk:"num_nodes_default"`
-	NumZookeeperNodesDefault    types.Int64  `tfsdk:"num_zookeeper_nodes_default"`
-	NumAdditionalZookeeperNodes types.Int64  `tfsdk:"num_additional_zookeeper_nodes"`
-	Servers                     types.List   `tfsdk:"servers"`
-	ZookeeperEnsemble           types.String `tfsdk:"zookeeper_ensemble"`
-	Tags                        types.List   `tfsdk:"tags"`
-	SpecJVMHeapMemory           types.String `tfsdk:"spec_jvm_heap_memory"`
-	SpecDiskSpace               types.String `tfsdk:"spec_disk_space"`
-	SpecPhysicalMemory          types.String `tfsdk:"spec_physical_memory"`
-	BackupsEnabled              types.Bool   `tfsdk:"backups_enabled"`
-	DrEnabled                   types.Bool   `tfsdk:"dr_enabled"`
-	SlaActive                   types.Bool   `tfsdk:"sla_active"`
-	ApplicationNodesCount       types.Int64  `tfsdk:"application_nodes_count"`
-	Subscription                types.String `tfsdk:"subscription"`
-	SecurityPack                types.Bool   `tfsdk:"security_pack"`
-	DesiredTier                 types.String `tfsdk:"desired_tier"`
+	ID                          types.String           `tfsdk:"id"`
+	AccountName                 types.String           `tfsdk:"account_name"`
+	UID                         types.String           `tfsdk:"uid"`
+	Name                        types.String           `tfsdk:"name"`
+	Application                 types.String           `tfsdk:"application"`
+	ApplicationVersion          types.String           `tfsdk:"application_version"`
+	TerminationLock             types.Bool             `tfsdk:"termination_lock"`
+	PlanType                    types.String           `tfsdk:"plan_type"`
+	Plan                        types.String           `tfsdk:"plan"`
+	RegionId                    types.String           `tfsdk:"region_id"`
+	CloudProvider               types.String           `tfsdk:"cloud_provider"`
+	CloudProviderId             types.String           `tfsdk:"cloud_provider_id"`
+	NumAdditionalAppNodes       types.Int64            `tfsdk:"num_additional_app_nodes"`
+	PrivateVpc                  types.Int64            `tfsdk:"private_vpc"`
+	Tier                        types.String           `tfsdk:"tier"`
+	HttpEndpoint                types.String           `tfsdk:"http_endpoint"`
+	ProvisionState              types.String           `tfsdk:"provision_state"`
+	Status                      types.String           `tfsdk:"status"`
+	DateCreated                 types.String           `tfsdk:"date_created"`
+	IsMasterSlave               types.Bool             `tfsdk:"is_master_slave"`
+	VpcType                     types.String           `tfsdk:"vpc_type"`
+	VpcName                     types.String           `tfsdk:"vpc_name"`
+	DeploymentType              types.String           `tfsdk:"deployment_type"`
+	NumNodesDefault             types.Int64            `tfsdk:"num_nodes_default"`
+	NumZookeeperNodesDefault    types.Int64            `tfsdk:"num_zookeeper_nodes_default"`
+	NumAdditionalZookeeperNodes types.Int64            `tfsdk:"num_additional_zookeeper_nodes"`
+	Servers                     types.List             `tfsdk:"servers"`
+	ZookeeperEnsemble           types.String           `tfsdk:"zookeeper_ensemble"`
+	Tags                        types.List             `tfsdk:"tags"`
+	SpecJVMHeapMemory           types.String           `tfsdk:"spec_jvm_heap_memory"`
+	SpecDiskSpace               types.String           `tfsdk:"spec_disk_space"`
+	SpecPhysicalMemory          types.String           `tfsdk:"spec_physical_memory"`
+	BackupsEnabled              types.Bool             `tfsdk:"backups_enabled"`
+	DrEnabled                   types.Bool             `tfsdk:"dr_enabled"`
+	SlaActive                   types.Bool             `tfsdk:"sla_active"`
+	ApplicationNodesCount       types.Int64            `tfsdk:"application_nodes_count"`
+	Subscription                types.String           `tfsdk:"subscription"`
+	SecurityPack                types.Bool             `tfsdk:"security_pack"`
+	DesiredTier                 types.String           `tfsdk:"desired_tier"`
+	AllowReplacement            types.Bool             `tfsdk:"allow_replacement"`
+	WaitForReady                types.Bool             `tfsdk:"wait_for_ready"`
+	AdoptExisting               types.Bool             `tfsdk:"adopt_existing"`
+	BackupOnDestroy             types.Bool             `tfsdk:"backup_on_destroy"`
+	Source                      *deploymentSourceModel `tfsdk:"source"`
+	Timeouts                    timeouts.Value         `tfsdk:"timeouts"`
 }
@@ -45,7 +45,7 @@ func (r *deploymentBackupResource) Create(ctx context.Context, req resource.Crea
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := r.client.CreateDeploymentBackup(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), map[string]any{})
+	out, err := r.client.CreateDeploymentBackup(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), map[string]any{})
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating deployment backup", err.Error())
 		return
@@ -60,7 +60,7 @@ func (r *deploymentBackupResource) Read(ctx context.Context, req resource.ReadRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := r.client.GetDeploymentBackups(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	list, err := r.client.GetDeploymentBackups(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading deployment backups", err.Error())
 		return
@@ -83,7 +83,7 @@ func (r *deploymentBackupResource) Delete(ctx context.Context, req resource.Dele
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.DeleteDeploymentBackup(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.BackupID.ValueString()); err != nil {
+	if err := r.client.DeleteDeploymentBackup(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.BackupID.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting deployment backup", err.Error())
 	}
 }
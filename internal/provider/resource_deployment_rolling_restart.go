@@ -3,9 +3,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	searchstaxClient "terraform-provider-searchstax/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -14,6 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// defaultRollingRestartCreateTimeout bounds the wait for the deployment to
+// report healthy again after the restart.
+const defaultRollingRestartCreateTimeout = 35 * time.Minute
+
 func NewDeploymentRollingRestartResource() resource.Resource {
 	return &deploymentRollingRestartResource{}
 }
@@ -24,33 +30,38 @@ func (r *deploymentRollingRestartResource) Metadata(_ context.Context, req resou
 	resp.TypeName = req.ProviderTypeName + "_deployment_rolling_restart"
 }
 
-func (r *deploymentRollingRestartResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{Attributes: map[string]schema.Attribute{
-		"id":             schema.StringAttribute{Computed: true},
-		"account_name":   schema.StringAttribute{Required: true},
-		"deployment_uid": schema.StringAttribute{Required: true},
-		"solr": schema.BoolAttribute{
-			Optional: true,
-			PlanModifiers: []planmodifier.Bool{
-				boolplanmodifier.RequiresReplace(),
+func (r *deploymentRollingRestartResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":             schema.StringAttribute{Computed: true},
+			"account_name":   schema.StringAttribute{Required: true},
+			"deployment_uid": schema.StringAttribute{Required: true},
+			"solr": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
 			},
-		},
-		"zookeeper": schema.BoolAttribute{
-			Optional: true,
-			PlanModifiers: []planmodifier.Bool{
-				boolplanmodifier.RequiresReplace(),
+			"zookeeper": schema.BoolAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
 			},
-		},
-		"triggers": schema.MapAttribute{
-			ElementType: types.StringType,
-			Optional:    true,
-			Description: "Arbitrary map of values that, when changed, forces a new rolling restart. Use it to trigger a single restart when the custom jar list changes.",
-			PlanModifiers: []planmodifier.Map{
-				mapplanmodifier.RequiresReplace(),
+			"triggers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, forces a new rolling restart. Use it to trigger a single restart when the custom jar list changes.",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
 			},
+			"message": schema.StringAttribute{Computed: true},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
 		},
-		"message": schema.StringAttribute{Computed: true},
-	}}
+	}
 }
 
 func (r *deploymentRollingRestartResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -79,11 +90,23 @@ func (r *deploymentRollingRestartResource) Create(ctx context.Context, req resou
 	if !plan.Zookeeper.IsNull() {
 		zookeeper = plan.Zookeeper.ValueBool()
 	}
-	out, err := r.client.RollingRestart(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.RollingRestartRequest{
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultRollingRestartCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	out, err := r.client.RollingRestart(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.RollingRestartRequest{
 		Solr:      solr,
 		Zookeeper: zookeeper,
 	})
 	if err != nil {
+		if timeoutDiag, ok := timeoutDiagnostic(err, "create"); ok {
+			resp.Diagnostics.Append(timeoutDiag)
+			return
+		}
 		resp.Diagnostics.AddError("Error initiating rolling restart", err.Error())
 		return
 	}
@@ -105,18 +128,29 @@ func (r *deploymentRollingRestartResource) Read(ctx context.Context, req resourc
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *deploymentRollingRestartResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+// Update only persists a changed timeouts block; the restart options force a
+// new resource instead.
+func (r *deploymentRollingRestartResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state deploymentRollingRestartResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *deploymentRollingRestartResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
 }
 
 type deploymentRollingRestartResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	AccountName   types.String `tfsdk:"account_name"`
-	DeploymentUID types.String `tfsdk:"deployment_uid"`
-	Solr          types.Bool   `tfsdk:"solr"`
-	Zookeeper     types.Bool   `tfsdk:"zookeeper"`
-	Triggers      types.Map    `tfsdk:"triggers"`
-	Message       types.String `tfsdk:"message"`
+	ID            types.String   `tfsdk:"id"`
+	AccountName   types.String   `tfsdk:"account_name"`
+	DeploymentUID types.String   `tfsdk:"deployment_uid"`
+	Solr          types.Bool     `tfsdk:"solr"`
+	Zookeeper     types.Bool     `tfsdk:"zookeeper"`
+	Triggers      types.Map      `tfsdk:"triggers"`
+	Message       types.String   `tfsdk:"message"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
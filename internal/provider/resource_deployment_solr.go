@@ -57,9 +57,9 @@ func (r *deploymentSolrResource) Create(ctx context.Context, req resource.Create
 	var err error
 	switch plan.Action.ValueString() {
 	case "start":
-		err = r.client.StartSolr(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), plan.Node.ValueString())
+		err = r.client.StartSolr(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), plan.Node.ValueString())
 	case "stop":
-		err = r.client.StopSolr(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), plan.Node.ValueString())
+		err = r.client.StopSolr(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), plan.Node.ValueString())
 	default:
 		resp.Diagnostics.AddError("Invalid action", "action must be start or stop")
 		return
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -93,6 +94,24 @@ resource "searchstax_deployment" "test" {
 					resource.TestCheckResourceAttr("searchstax_deployment.test", "num_additional_app_nodes", "0"),
 				),
 			},
+			// A replacement-forcing change is refused without allow_replacement
+			{
+				Config: providerConfig + `
+resource "searchstax_deployment" "test" {
+  account_name             = "test_account_name"
+  name                     = "SolrFromAPI"
+  application              = "Solr"
+  application_version      = "8.11.2"
+  termination_lock         = "false"
+  plan_type                = "DedicatedDeployment"
+  plan                     = "NDC4-GCP-G"
+  region_id                = "us-east-1"
+  cloud_provider_id        = "gcp"
+  num_additional_app_nodes = "0"
+}
+`,
+				ExpectError: regexp.MustCompile("Deployment Replacement Not Allowed"),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -3,7 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,8 +20,13 @@ import (
 // Ensure the implementation satisfies the expected interfaces.
 var (
 	_ resource.ResourceWithImportState = &deploymentUserResource{}
+	_ resource.ResourceWithModifyPlan  = &deploymentUserResource{}
 )
 
+// defaultDeploymentUserTimeout bounds the retries that ride out the Solr
+// restart triggered by enabling basic auth.
+const defaultDeploymentUserTimeout = 15 * time.Minute
+
 // NewDeploymentUserResource is a helper function to simplify the provider implementation.
 func NewDeploymentUserResource() resource.Resource {
 	return &deploymentUserResource{}
@@ -55,7 +63,7 @@ func (d *deploymentUserResource) Metadata(_ context.Context, req resource.Metada
 }
 
 // Schema defines the schema for the resource.
-func (d *deploymentUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (d *deploymentUserResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			// id is required by the testing framework
@@ -81,17 +89,63 @@ func (d *deploymentUserResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 			"password": schema.StringAttribute{
-				Required:      true,
-				Sensitive:     true,
-				PlanModifiers: []planmodifier.String{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password of the user, stored in state. Set exactly one of `password`, `password_wo` or `generate_password`.",
+				PlanModifiers:       []planmodifier.String{
 					// password rotation is modeled as update via delete+add in the client.
 					// keep it updatable without forcing a replace in state.
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				MarkdownDescription: "Write-only password of the user, never stored in plan or state. Requires " +
+					"Terraform 1.11 or later. Since Terraform cannot tell when it changes, the password is only " +
+					"set again when `password_wo_version` changes, or when `password_wo` replaces `password` or " +
+					"`generate_password`.",
+			},
+			"password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Change this value to set `password_wo` again, for example to rotate it.",
+			},
+			"generate_password": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "When `true`, the provider generates a random 24-character password mixing upper and " +
+					"lower case letters, digits and symbols, and exposes it as `generated_password`. Defaults to `false`.",
+			},
+			"rotation_days": schema.Int64Attribute{
+				Optional: true,
+				MarkdownDescription: "Generate a new password once the current one is this many days old. The rotation is " +
+					"planned by the first plan after that, and the user is updated in place.",
+			},
+			"keepers": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Arbitrary values that generate a new password, in place, whenever they change. " +
+					"Use them to rotate on demand or together with other resources.",
+			},
+			"generated_password": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "The password generated with `generate_password`. It is stored in state.",
+			},
+			"password_generated_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When `generated_password` was generated, in RFC 3339 format.",
+			},
 			"role": schema.StringAttribute{
 				Required: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -105,20 +159,54 @@ func (d *deploymentUserResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	var config deploymentUserModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Generate API request body from plan
+	password := plan.Password.ValueString()
+	if !config.PasswordWO.IsNull() {
+		password = config.PasswordWO.ValueString()
+	}
+	if plan.GeneratePassword.ValueBool() {
+		generated, err := generateSolrPassword()
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating deployment user", err.Error())
+			return
+		}
+		password = generated
+		plan.GeneratedPassword = types.StringValue(generated)
+		plan.PasswordGeneratedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+	}
 	var item = searchstaxClient.DeploymentUser{
 		Username: plan.Username.ValueString(),
-		Password: plan.Password.ValueString(),
+		Password: password,
 		Role:     plan.Role.ValueString(),
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultDeploymentUserTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create new basic-auth user
-	var _, err = d.client.CreateDeploymentUser(item, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString())
+	var _, err = d.client.CreateDeploymentUser(ctx, item, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(
+		if timeoutDiag, ok := timeoutDiagnostic(err, "create"); ok {
+			resp.Diagnostics.Append(timeoutDiag)
+			return
+		}
+		resp.Diagnostics.Append(apiErrorDiagnostics(
 			"Error creating deployment user",
-			"Could not create deployment user, unexpected error: "+err.Error(),
-		)
+			"Could not create deployment user",
+			err, "username", "password", "password_wo", "role",
+		)...)
 		return
 	}
 
@@ -144,7 +232,7 @@ func (d *deploymentUserResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get refreshed user value from SearchStax
-	var user, err = d.client.GetDeploymentUser(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Username.ValueString())
+	var user, err = d.client.GetDeploymentUser(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Username.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading SearchStax deployment user",
@@ -183,32 +271,68 @@ func (d *deploymentUserResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	var config deploymentUserModel
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultDeploymentUserTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	accountName := plan.AccountName.ValueString()
 	deploymentUID := plan.DeploymentUID.ValueString()
 	username := plan.Username.ValueString()
 
-	if !plan.Password.Equal(state.Password) {
-		if err := d.client.SetBasicAuthPassword(accountName, deploymentUID, searchstaxClient.SetBasicAuthPasswordRequest{
+	password, setPassword := plan.Password.ValueString(), !plan.Password.Equal(state.Password)
+	if !config.PasswordWO.IsNull() {
+		password = config.PasswordWO.ValueString()
+		setPassword = writeOnlyPasswordDue(plan, state)
+	}
+	// ModifyPlan leaves generated_password unknown when a new password is due.
+	if plan.GeneratePassword.ValueBool() {
+		setPassword = plan.GeneratedPassword.IsUnknown()
+		if setPassword {
+			generated, err := generateSolrPassword()
+			if err != nil {
+				resp.Diagnostics.AddError("Error Updating SearchStax Deployment User Password", err.Error())
+				return
+			}
+			password = generated
+			plan.GeneratedPassword = types.StringValue(generated)
+			plan.PasswordGeneratedAt = types.StringValue(time.Now().UTC().Format(time.RFC3339))
+		}
+	}
+	if setPassword {
+		if err := d.client.SetBasicAuthPassword(ctx, accountName, deploymentUID, searchstaxClient.SetBasicAuthPasswordRequest{
 			Username: username,
-			Password: plan.Password.ValueString(),
+			Password: password,
 		}); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiErrorDiagnostics(
 				"Error Updating SearchStax Deployment User Password",
-				"Could not update deployment user password, unexpected error: "+err.Error(),
-			)
+				"Could not update deployment user password",
+				err, "password", "password_wo",
+			)...)
 			return
 		}
 	}
 
 	if !plan.Role.Equal(state.Role) {
-		if err := d.client.SetBasicAuthRole(accountName, deploymentUID, searchstaxClient.SetBasicAuthRoleRequest{
+		if err := d.client.SetBasicAuthRole(ctx, accountName, deploymentUID, searchstaxClient.SetBasicAuthRoleRequest{
 			Username: username,
 			Role:     plan.Role.ValueString(),
 		}); err != nil {
-			resp.Diagnostics.AddError(
+			resp.Diagnostics.Append(apiErrorDiagnostics(
 				"Error Updating SearchStax Deployment User Role",
-				"Could not update deployment user role, unexpected error: "+err.Error(),
-			)
+				"Could not update deployment user role",
+				err, "role",
+			)...)
 			return
 		}
 	}
@@ -231,9 +355,21 @@ func (d *deploymentUserResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeploymentUserTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete user
-	err := d.client.DeleteDeploymentUser(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Username.ValueString())
+	err := d.client.DeleteDeploymentUser(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Username.ValueString())
 	if err != nil {
+		if timeoutDiag, ok := timeoutDiagnostic(err, "delete"); ok {
+			resp.Diagnostics.Append(timeoutDiag)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting SearchStax Deployment User",
 			"Could not delete deployment user, unexpected error: "+err.Error(),
@@ -242,6 +378,86 @@ func (d *deploymentUserResource) Delete(ctx context.Context, req resource.Delete
 	}
 }
 
+// ModifyPlan checks that the password is set with exactly one of password,
+// password_wo or generate_password, and plans a new generated password when
+// it is missing, rotation_days have passed or the keepers changed. Write-only
+// values are only in the configuration.
+func (d *deploymentUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var config deploymentUserModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateDeploymentUserPassword(config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan deploymentUserModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.GeneratePassword.IsUnknown() {
+		return
+	}
+	switch {
+	case !plan.GeneratePassword.ValueBool():
+		plan.GeneratedPassword = types.StringNull()
+		plan.PasswordGeneratedAt = types.StringNull()
+	case req.State.Raw.IsNull():
+		plan.GeneratedPassword = types.StringUnknown()
+		plan.PasswordGeneratedAt = types.StringUnknown()
+	default:
+		var state deploymentUserModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.GeneratedPassword.IsNull() || !plan.Keepers.Equal(state.Keepers) ||
+			passwordRotationDue(state.PasswordGeneratedAt, plan.RotationDays, time.Now()) {
+			plan.GeneratedPassword = types.StringUnknown()
+			plan.PasswordGeneratedAt = types.StringUnknown()
+		} else {
+			plan.GeneratedPassword = state.GeneratedPassword
+			plan.PasswordGeneratedAt = state.PasswordGeneratedAt
+		}
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// validateDeploymentUserPassword checks the password attributes of config.
+func validateDeploymentUserPassword(config deploymentUserModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if config.Password.IsUnknown() || config.PasswordWO.IsUnknown() || config.GeneratePassword.IsUnknown() {
+		return diags
+	}
+	generate := config.GeneratePassword.ValueBool()
+	set := 0
+	for _, isSet := range []bool{!config.Password.IsNull(), !config.PasswordWO.IsNull(), generate} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		diags.AddAttributeError(path.Root("password"), "Invalid Deployment User Password",
+			"Set exactly one of password, password_wo or generate_password = true.")
+	}
+	if !config.PasswordWOVersion.IsNull() && config.PasswordWO.IsNull() {
+		diags.AddAttributeError(path.Root("password_wo_version"), "Invalid Deployment User Password",
+			"password_wo_version can only be set with password_wo.")
+	}
+	if !generate && (!config.RotationDays.IsNull() || !config.Keepers.IsNull()) {
+		diags.AddAttributeError(path.Root("generate_password"), "Invalid Deployment User Password",
+			"rotation_days and keepers can only be set with generate_password = true.")
+	}
+	if !config.RotationDays.IsNull() && !config.RotationDays.IsUnknown() && config.RotationDays.ValueInt64() < 1 {
+		diags.AddAttributeError(path.Root("rotation_days"), "Invalid Deployment User Password",
+			"rotation_days must be at least 1.")
+	}
+	return diags
+}
+
 // ImportState - Import existing deployment cluster into terraform state.
 func (d *deploymentUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, "/")
@@ -261,10 +477,19 @@ func (d *deploymentUserResource) ImportState(ctx context.Context, req resource.I
 
 // deploymentUserModel maps deployment schema data.
 type deploymentUserModel struct {
-	ID            types.String `tfsdk:"id"`
-	AccountName   types.String `tfsdk:"account_name"`
-	DeploymentUID types.String `tfsdk:"deployment_uid"`
-	Username      types.String `tfsdk:"username"`
-	Password      types.String `tfsdk:"password"`
-	Role          types.String `tfsdk:"role"`
+	ID                types.String   `tfsdk:"id"`
+	AccountName       types.String   `tfsdk:"account_name"`
+	DeploymentUID     types.String   `tfsdk:"deployment_uid"`
+	Username          types.String   `tfsdk:"username"`
+	Password          types.String   `tfsdk:"password"`
+	PasswordWO        types.String   `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64    `tfsdk:"password_wo_version"`
+	Role              types.String   `tfsdk:"role"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+
+	GeneratePassword    types.Bool   `tfsdk:"generate_password"`
+	RotationDays        types.Int64  `tfsdk:"rotation_days"`
+	Keepers             types.Map    `tfsdk:"keepers"`
+	GeneratedPassword   types.String `tfsdk:"generated_password"`
+	PasswordGeneratedAt types.String `tfsdk:"password_generated_at"`
 }
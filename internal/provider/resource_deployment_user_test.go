@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/compare"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccDeploymentUserResource(t *testing.T) {
@@ -44,3 +50,108 @@ resource "searchstax_deployment_user" "test" {
 		},
 	})
 }
+
+func TestAccDeploymentUserResourceWriteOnlyPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "searchstax_deployment_user" "test" {
+  account_name        = "test_account_name"
+  deployment_uid      = "ss123456"
+  username            = "demoSolrWO"
+  password_wo         = "test123"
+  password_wo_version = 1
+  role                = "Admin"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("searchstax_deployment_user.test", "password_wo"),
+					resource.TestCheckNoResourceAttr("searchstax_deployment_user.test", "password"),
+					resource.TestCheckResourceAttr("searchstax_deployment_user.test", "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "searchstax_deployment_user" "test" {
+  account_name        = "test_account_name"
+  deployment_uid      = "ss123456"
+  username            = "demoSolrWO"
+  password_wo         = "rotated-password"
+  password_wo_version = 2
+  role                = "Admin"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("searchstax_deployment_user.test", "password_wo"),
+					resource.TestCheckResourceAttr("searchstax_deployment_user.test", "password_wo_version", "2"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "searchstax_deployment_user" "test" {
+  account_name   = "test_account_name"
+  deployment_uid = "ss123456"
+  username       = "demoSolrWO"
+  password       = "test123"
+  password_wo    = "test123"
+  role           = "Admin"
+}`,
+				ExpectError: regexp.MustCompile(`Set exactly one of password, password_wo or`),
+			},
+		},
+	})
+}
+
+func TestAccDeploymentUserResourceGeneratedPassword(t *testing.T) {
+	generatedPassword := statecheck.CompareValue(compare.ValuesDiffer())
+	config := func(keeper string) string {
+		return providerConfig + `
+resource "searchstax_deployment_user" "test" {
+  account_name      = "test_account_name"
+  deployment_uid    = "ss123456"
+  username          = "demoSolrGenerated"
+  generate_password = true
+  rotation_days     = 30
+  role              = "Admin"
+
+  keepers = {
+    rotation = "` + keeper + `"
+  }
+}`
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("searchstax_deployment_user.test", "generated_password"),
+					resource.TestCheckResourceAttrSet("searchstax_deployment_user.test", "password_generated_at"),
+					resource.TestCheckNoResourceAttr("searchstax_deployment_user.test", "password"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					generatedPassword.AddStateValue("searchstax_deployment_user.test", tfjsonpath.New("generated_password")),
+				},
+			},
+			{
+				// Changing a keeper rotates the password in place.
+				Config: config("2"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					generatedPassword.AddStateValue("searchstax_deployment_user.test", tfjsonpath.New("generated_password")),
+				},
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("searchstax_deployment_user.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config:   config("2"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -53,7 +53,7 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	record, err := r.client.AssociateDNSRecord(plan.AccountName.ValueString(), plan.Name.ValueString(), searchstaxClient.AssociateDNSRecordRequest{
+	record, err := r.client.AssociateDNSRecord(ctx, plan.AccountName.ValueString(), plan.Name.ValueString(), searchstaxClient.AssociateDNSRecordRequest{
 		Deployment: plan.Deployment.ValueString(),
 		TTL:        plan.TTL.ValueString(),
 	})
@@ -73,7 +73,7 @@ func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	record, err := r.client.GetDNSRecord(state.AccountName.ValueString(), state.Name.ValueString())
+	record, err := r.client.GetDNSRecord(ctx, state.AccountName.ValueString(), state.Name.ValueString())
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -90,7 +90,7 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	record, err := r.client.AssociateDNSRecord(plan.AccountName.ValueString(), plan.Name.ValueString(), searchstaxClient.AssociateDNSRecordRequest{
+	record, err := r.client.AssociateDNSRecord(ctx, plan.AccountName.ValueString(), plan.Name.ValueString(), searchstaxClient.AssociateDNSRecordRequest{
 		Deployment: plan.Deployment.ValueString(),
 		TTL:        plan.TTL.ValueString(),
 	})
@@ -111,7 +111,7 @@ func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_, _ = r.client.AssociateDNSRecord(state.AccountName.ValueString(), state.Name.ValueString(), searchstaxClient.AssociateDNSRecordRequest{Deployment: ""})
+	_, _ = r.client.AssociateDNSRecord(ctx, state.AccountName.ValueString(), state.Name.ValueString(), searchstaxClient.AssociateDNSRecordRequest{Deployment: ""})
 }
 
 func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -77,9 +77,9 @@ func (r *heartbeatResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	id, err := r.client.CreateHeartbeat(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), r.heartbeatFromPlan(ctx, plan))
+	id, err := r.client.CreateHeartbeat(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), r.heartbeatFromPlan(ctx, plan))
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating heartbeat", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error creating heartbeat", "Could not create heartbeat", err, "name", "host", "interval", "max_alerts", "email", "webhook_trigger", "webhook_resolve")...)
 		return
 	}
 	plan.HeartbeatID = types.Int64Value(id)
@@ -93,7 +93,7 @@ func (r *heartbeatResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	hb, err := r.client.GetHeartbeat(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.HeartbeatID.ValueInt64())
+	hb, err := r.client.GetHeartbeat(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.HeartbeatID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading heartbeat", err.Error())
 		return
@@ -131,8 +131,8 @@ func (r *heartbeatResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.UpdateHeartbeat(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), plan.HeartbeatID.ValueInt64(), r.heartbeatFromPlan(ctx, plan)); err != nil {
-		resp.Diagnostics.AddError("Error updating heartbeat", err.Error())
+	if err := r.client.UpdateHeartbeat(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), plan.HeartbeatID.ValueInt64(), r.heartbeatFromPlan(ctx, plan)); err != nil {
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error updating heartbeat", "Could not update heartbeat", err, "name", "host", "interval", "max_alerts", "email", "webhook_trigger", "webhook_resolve")...)
 		return
 	}
 	plan.ID = types.StringValue(plan.AccountName.ValueString() + "/" + plan.DeploymentUID.ValueString() + "/" + strconv.FormatInt(plan.HeartbeatID.ValueInt64(), 10))
@@ -145,7 +145,7 @@ func (r *heartbeatResource) Delete(ctx context.Context, req resource.DeleteReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.DeleteHeartbeat(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.HeartbeatID.ValueInt64()); err != nil {
+	if err := r.client.DeleteHeartbeat(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.HeartbeatID.ValueInt64()); err != nil {
 		resp.Diagnostics.AddError("Error deleting heartbeat", err.Error())
 	}
 }
@@ -60,13 +60,13 @@ func (r *ipFilterResource) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	err := r.client.AddIPFilter(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.IPFilterUpsertRequest{
+	err := r.client.AddIPFilter(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.IPFilterUpsertRequest{
 		CIDRIP:      plan.CIDRIP.ValueString(),
 		Description: plan.Description.ValueString(),
 		Services:    services,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating IP filter", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error creating IP filter", "Could not create IP filter", err, "cidr_ip", "services", "description")...)
 		return
 	}
 	plan.ID = types.StringValue(plan.AccountName.ValueString() + "/" + plan.DeploymentUID.ValueString() + "/" + plan.CIDRIP.ValueString())
@@ -78,7 +78,7 @@ func (r *ipFilterResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := r.client.GetIPFilters(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	list, err := r.client.GetIPFilters(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading IP filters", err.Error())
 		return
@@ -108,13 +108,13 @@ func (r *ipFilterResource) Update(ctx context.Context, req resource.UpdateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	err := r.client.UpdateIPFilter(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.IPFilterUpsertRequest{
+	err := r.client.UpdateIPFilter(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.IPFilterUpsertRequest{
 		CIDRIP:      plan.CIDRIP.ValueString(),
 		Description: plan.Description.ValueString(),
 		Services:    services,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating IP filter", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error updating IP filter", "Could not update IP filter", err, "cidr_ip", "services", "description")...)
 		return
 	}
 	plan.ID = types.StringValue(plan.AccountName.ValueString() + "/" + plan.DeploymentUID.ValueString() + "/" + plan.CIDRIP.ValueString())
@@ -126,7 +126,7 @@ func (r *ipFilterResource) Delete(ctx context.Context, req resource.DeleteReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	err := r.client.DeleteIPFilter(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), searchstaxClient.IPFilterDeleteRequest{
+	err := r.client.DeleteIPFilter(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), searchstaxClient.IPFilterDeleteRequest{
 		CIDRIP: state.CIDRIP.ValueString(),
 	})
 	if err != nil {
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	searchstaxClient "terraform-provider-searchstax/internal/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// defaultRestoreCreateTimeout bounds the restore request and the wait for it
+// to finish.
+const defaultRestoreCreateTimeout = 30 * time.Minute
+
 func NewRestoreResource() resource.Resource { return &restoreResource{} }
 
 type restoreResource struct{ client *searchstaxClient.Client }
@@ -23,25 +29,50 @@ func (r *restoreResource) Metadata(_ context.Context, req resource.MetadataReque
 	resp.TypeName = req.ProviderTypeName + "_restore"
 }
 
-func (r *restoreResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{Attributes: map[string]schema.Attribute{
-		"id":           schema.StringAttribute{Computed: true},
-		"account_name": schema.StringAttribute{Required: true},
-		"backup_id": schema.StringAttribute{
-			Required: true,
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.RequiresReplace(),
+func (r *restoreResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restores a backup into a SearchStax deployment.\n\n" +
+			"A deployment restore (one with `deployment_uid`) waits until SearchStax reports the restore as " +
+			"finished, logging each new status, so that dependent resources only see a fully restored index. " +
+			"A failed restore is an error. \"No restore in progress\" ends the wait once the restore has been " +
+			"seen queued or running, or two minutes after it was accepted, so a restore that finishes before the " +
+			"first poll is not waited on until the timeout. " +
+			"The wait defaults to 30 minutes and can be changed with a `timeouts` " +
+			"block; set `wait_for_completion = false` to return as soon as the restore is accepted. An account " +
+			"restore (one without `deployment_uid`) names no target deployment whose restore status could be " +
+			"polled, so it never waits. To restore an account backup into a deployment and wait for it, use the " +
+			"`source` block of `searchstax_deployment`, which waits on the new deployment's restore status.",
+		Attributes: map[string]schema.Attribute{
+			"id":           schema.StringAttribute{Computed: true},
+			"account_name": schema.StringAttribute{Required: true},
+			"backup_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
-		},
-		"deployment_uid": schema.StringAttribute{
-			Optional: true,
-			PlanModifiers: []planmodifier.String{
-				stringplanmodifier.RequiresReplace(),
+			"deployment_uid": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Whether creating a deployment restore waits until it has finished. " +
+					"Defaults to `true`.",
 			},
+			"message": schema.StringAttribute{Computed: true},
+			"status": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "Coarse restore state derived from `message`: `Queued`, `In Progress`, `Completed`, `Failed`, " +
+					"`None` (no restore running, e.g. \"No restore in progress\") or `Unknown` for an unrecognized message.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
 		},
-		"message": schema.StringAttribute{Computed: true},
-		"status":  schema.StringAttribute{Computed: true},
-	}}
+	}
 }
 
 func (r *restoreResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -62,28 +93,51 @@ func (r *restoreResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultRestoreCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	reqBody := searchstaxClient.RestoreRequest{BackupID: plan.BackupID.ValueString()}
 	var out *searchstaxClient.RestoreResponse
 	var err error
 	if plan.DeploymentUID.IsNull() {
-		out, err = r.client.CreateAccountRestore(plan.AccountName.ValueString(), reqBody)
+		out, err = r.client.CreateAccountRestore(ctx, plan.AccountName.ValueString(), reqBody)
 	} else {
-		out, err = r.client.CreateDeploymentRestore(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), reqBody)
+		out, err = r.client.CreateDeploymentRestore(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), reqBody)
 	}
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating restore", err.Error())
 		return
 	}
 	message := out.Message
-	// For deployment restores, prefer the live status message so create and
-	// subsequent reads report the same value.
+	status := searchstaxClient.RestoreStatus(message)
+	// For deployment restores, wait for the restore to finish, or prefer the
+	// live status message so create and subsequent reads report the same
+	// value.
 	if !plan.DeploymentUID.IsNull() {
-		if status, err := r.client.GetDeploymentRestoreStatus(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), reqBody); err == nil && status.Message != "" {
-			message = status.Message
+		if plan.WaitForCompletion.IsNull() || plan.WaitForCompletion.ValueBool() {
+			final, err := r.client.WaitForDeploymentRestore(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), plan.BackupID.ValueString())
+			if err != nil {
+				if timeoutDiag, ok := timeoutDiagnostic(err, "create"); ok {
+					resp.Diagnostics.Append(timeoutDiag)
+					return
+				}
+				resp.Diagnostics.AddError("Error restoring backup", err.Error())
+				return
+			}
+			// The wait also ends when SearchStax reports "no restore" after
+			// the restore was seen running or the start grace period passed.
+			message, status = final.Message, searchstaxClient.RestoreStatusCompleted
+		} else if live, err := r.client.GetDeploymentRestoreStatus(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), reqBody); err == nil && live.Message != "" {
+			message, status = live.Message, searchstaxClient.RestoreStatus(live.Message)
 		}
 	}
 	plan.Message = types.StringValue(message)
-	plan.Status = types.StringValue(restoreStatusFromMessage(message))
+	plan.Status = types.StringValue(status)
 	if plan.DeploymentUID.IsNull() {
 		plan.ID = types.StringValue(plan.AccountName.ValueString() + "/" + plan.BackupID.ValueString())
 	} else {
@@ -105,17 +159,29 @@ func (r *restoreResource) Read(ctx context.Context, req resource.ReadRequest, re
 		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 		return
 	}
-	out, err = r.client.GetDeploymentRestoreStatus(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), reqBody)
+	out, err = r.client.GetDeploymentRestoreStatus(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), reqBody)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading restore status", err.Error())
 		return
 	}
 	state.Message = types.StringValue(out.Message)
-	state.Status = types.StringValue(restoreStatusFromMessage(out.Message))
+	state.Status = types.StringValue(searchstaxClient.RestoreStatus(out.Message))
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
-func (r *restoreResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+// Update only persists a changed timeouts block or wait_for_completion; the
+// restore inputs force a new resource instead.
+func (r *restoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state restoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+	state.WaitForCompletion = plan.WaitForCompletion
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
 
 func (r *restoreResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
 
@@ -135,29 +201,13 @@ func (r *restoreResource) ImportState(ctx context.Context, req resource.ImportSt
 	resp.Diagnostics.AddError("Unexpected Import Identifier", "Expected account_name/backup_id or account_name/deployment_uid/backup_id")
 }
 
-// restoreStatusFromMessage derives a coarse status from the message string the
-// SearchStax restore endpoints return.
-func restoreStatusFromMessage(message string) string {
-	m := strings.ToLower(message)
-	switch {
-	case message == "":
-		return ""
-	case strings.Contains(m, "in progress"):
-		return "In Progress"
-	case strings.Contains(m, "no restore"):
-		return "None"
-	case strings.Contains(m, "begun"), strings.Contains(m, "queue"), strings.Contains(m, "placed in the task"):
-		return "Queued"
-	default:
-		return "Unknown"
-	}
-}
-
 type restoreResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	AccountName   types.String `tfsdk:"account_name"`
-	DeploymentUID types.String `tfsdk:"deployment_uid"`
-	BackupID      types.String `tfsdk:"backup_id"`
-	Message       types.String `tfsdk:"message"`
-	Status        types.String `tfsdk:"status"`
+	ID                types.String   `tfsdk:"id"`
+	AccountName       types.String   `tfsdk:"account_name"`
+	DeploymentUID     types.String   `tfsdk:"deployment_uid"`
+	BackupID          types.String   `tfsdk:"backup_id"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	Message           types.String   `tfsdk:"message"`
+	Status            types.String   `tfsdk:"status"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
@@ -1,9 +1,15 @@
 package provider
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccRestoreResource(t *testing.T) {
@@ -16,6 +22,9 @@ resource "searchstax_restore" "test" {
   account_name   = "test_account_name"
   deployment_uid = "ss123456"
   backup_id      = "27004"
+
+  # The mock API reports every restore as in progress.
+  wait_for_completion = false
 }`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("searchstax_restore.test", "backup_id", "27004"),
@@ -26,3 +35,57 @@ resource "searchstax_restore" "test" {
 		},
 	})
 }
+
+// TestAccRestoreResourceWaitsForCompletion runs a restore against a scripted
+// API that reports it queued, then in progress, then finished, since the mock
+// API reports every restore as in progress.
+func TestAccRestoreResourceWaitsForCompletion(t *testing.T) {
+	var statusPolls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/restore/status/"):
+			switch statusPolls.Add(1) {
+			case 1:
+				_, _ = w.Write([]byte(`{"message": "Restore has been placed in the task queue"}`))
+			case 2:
+				_, _ = w.Write([]byte(`{"message": "Backup Restore in Progress"}`))
+			default:
+				_, _ = w.Write([]byte(`{"message": "No restore in progress"}`))
+			}
+		case strings.HasSuffix(r.URL.Path, "/restore/"):
+			_, _ = w.Write([]byte(`{"message": "restore begun"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "searchstax" {
+  host  = %q
+  token = "test-token"
+}
+
+resource "searchstax_restore" "test" {
+  account_name   = "test_account_name"
+  deployment_uid = "ss123456"
+  backup_id      = "27004"
+}`, server.URL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("searchstax_restore.test", "status", "Completed"),
+					resource.TestCheckResourceAttr("searchstax_restore.test", "message", "No restore in progress"),
+					func(*terraform.State) error {
+						if polls := statusPolls.Load(); polls < 3 {
+							return fmt.Errorf("restore status polled %d times before create returned, want at least 3", polls)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
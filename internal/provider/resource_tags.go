@@ -47,7 +47,7 @@ func (r *tagsResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 	var tags []string
 	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &tags, false)...)
-	if err := r.client.AddOrUpdateTags(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.UpdateTagsRequest{Tags: tags}); err != nil {
+	if err := r.client.AddOrUpdateTags(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.UpdateTagsRequest{Tags: tags}); err != nil {
 		resp.Diagnostics.AddError("Error setting tags", err.Error())
 		return
 	}
@@ -60,7 +60,7 @@ func (r *tagsResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := r.client.GetTags(state.AccountName.ValueString(), state.DeploymentUID.ValueString())
+	out, err := r.client.GetTags(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading tags", err.Error())
 		return
@@ -82,7 +82,7 @@ func (r *tagsResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.AddOrUpdateTags(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.UpdateTagsRequest{Tags: tags}); err != nil {
+	if err := r.client.AddOrUpdateTags(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.UpdateTagsRequest{Tags: tags}); err != nil {
 		resp.Diagnostics.AddError("Error updating tags", err.Error())
 		return
 	}
@@ -97,7 +97,7 @@ func (r *tagsResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 	var tags []string
 	_ = state.Tags.ElementsAs(ctx, &tags, false)
-	_ = r.client.DeleteTags(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), searchstaxClient.UpdateTagsRequest{Tags: tags})
+	_ = r.client.DeleteTags(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), searchstaxClient.UpdateTagsRequest{Tags: tags})
 }
 func (r *tagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	parts := strings.Split(req.ID, "/")
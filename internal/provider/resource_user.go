@@ -17,6 +17,7 @@ import (
 
 var (
 	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithModifyPlan  = &userResource{}
 )
 
 func NewUserResource() resource.Resource {
@@ -55,6 +56,18 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:  true,
 				Sensitive: true,
 			},
+			"new_password_wo": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				MarkdownDescription: "Write-only variant of `new_password`, never stored in plan or state. Requires " +
+					"Terraform 1.11 or later. The password is set on create and again whenever " +
+					"`new_password_wo_version` changes.",
+			},
+			"new_password_wo_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Change this value to set `new_password_wo` again, for example to rotate it.",
+			},
 		},
 	}
 }
@@ -75,28 +88,33 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 }
 
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan userResourceModel
+	var plan, config userResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if err := r.client.InviteUser(searchstaxClient.InviteUserRequest{
+	if err := r.client.InviteUser(ctx, searchstaxClient.InviteUserRequest{
 		Email:     plan.Email.ValueString(),
 		Role:      plan.Role.ValueString(),
 		FirstName: plan.FirstName.ValueString(),
 		LastName:  plan.LastName.ValueString(),
 	}); err != nil {
-		resp.Diagnostics.AddError("Error inviting SearchStax user", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error inviting SearchStax user", "Could not invite user", err, "email", "role", "first_name", "last_name")...)
 		return
 	}
 
-	if !plan.NewPassword.IsNull() && plan.NewPassword.ValueString() != "" {
-		if err := r.client.ChangeUserPassword(searchstaxClient.ChangeUserPasswordRequest{
+	newPassword := plan.NewPassword.ValueString()
+	if !config.NewPasswordWO.IsNull() {
+		newPassword = config.NewPasswordWO.ValueString()
+	}
+	if newPassword != "" {
+		if err := r.client.ChangeUserPassword(ctx, searchstaxClient.ChangeUserPasswordRequest{
 			Email:       plan.Email.ValueString(),
-			NewPassword: plan.NewPassword.ValueString(),
+			NewPassword: newPassword,
 		}); err != nil {
-			resp.Diagnostics.AddError("Error setting SearchStax user password", err.Error())
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error setting SearchStax user password", "Could not set user password", err, "new_password", "new_password_wo")...)
 			return
 		}
 	}
@@ -112,7 +130,7 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	users, err := r.client.GetUsers()
+	users, err := r.client.GetUsers(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading SearchStax users", err.Error())
 		return
@@ -141,28 +159,38 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan userResourceModel
 	var state userResourceModel
+	var config userResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	if plan.Role.ValueString() != state.Role.ValueString() {
-		if err := r.client.SetUserRole(searchstaxClient.SetUserRoleRequest{
+		if err := r.client.SetUserRole(ctx, searchstaxClient.SetUserRoleRequest{
 			Email: plan.Email.ValueString(),
 			Role:  plan.Role.ValueString(),
 		}); err != nil {
-			resp.Diagnostics.AddError("Error updating SearchStax user role", err.Error())
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error updating SearchStax user role", "Could not update user role", err, "role")...)
 			return
 		}
 	}
 
-	if !plan.NewPassword.IsNull() && plan.NewPassword.ValueString() != "" && plan.NewPassword.ValueString() != state.NewPassword.ValueString() {
-		if err := r.client.ChangeUserPassword(searchstaxClient.ChangeUserPasswordRequest{
+	// The write-only password is not in state to compare with, so it is only
+	// sent again when its version changes or when it replaces new_password.
+	newPassword := plan.NewPassword.ValueString()
+	setPassword := newPassword != "" && newPassword != state.NewPassword.ValueString()
+	if !config.NewPasswordWO.IsNull() {
+		newPassword = config.NewPasswordWO.ValueString()
+		setPassword = newPassword != "" && (!plan.NewPasswordWOVersion.Equal(state.NewPasswordWOVersion) || !state.NewPassword.IsNull())
+	}
+	if setPassword {
+		if err := r.client.ChangeUserPassword(ctx, searchstaxClient.ChangeUserPasswordRequest{
 			Email:       plan.Email.ValueString(),
-			NewPassword: plan.NewPassword.ValueString(),
+			NewPassword: newPassword,
 		}); err != nil {
-			resp.Diagnostics.AddError("Error updating SearchStax user password", err.Error())
+			resp.Diagnostics.Append(apiErrorDiagnostics("Error updating SearchStax user password", "Could not update user password", err, "new_password", "new_password_wo")...)
 			return
 		}
 	}
@@ -177,12 +205,33 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.DeleteUser(searchstaxClient.DeleteUserRequest{Email: state.Email.ValueString()}); err != nil {
+	if err := r.client.DeleteUser(ctx, searchstaxClient.DeleteUserRequest{Email: state.Email.ValueString()}); err != nil {
 		resp.Diagnostics.AddError("Error deleting SearchStax user", err.Error())
 		return
 	}
 }
 
+// ModifyPlan checks that at most one of new_password and new_password_wo is
+// set. Write-only values are only in the configuration.
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var config userResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !config.NewPassword.IsNull() && !config.NewPasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("new_password_wo"), "Invalid User Password",
+			"Set at most one of new_password or new_password_wo.")
+	}
+	if !config.NewPasswordWOVersion.IsNull() && config.NewPasswordWO.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("new_password_wo_version"), "Invalid User Password",
+			"new_password_wo_version can only be set with new_password_wo.")
+	}
+}
+
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("email"), req.ID)...)
 }
@@ -194,4 +243,7 @@ type userResourceModel struct {
 	FirstName   types.String `tfsdk:"first_name"`
 	LastName    types.String `tfsdk:"last_name"`
 	NewPassword types.String `tfsdk:"new_password"`
+
+	NewPasswordWO        types.String `tfsdk:"new_password_wo"`
+	NewPasswordWOVersion types.Int64  `tfsdk:"new_password_wo_version"`
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccUserResource(t *testing.T) {
@@ -36,3 +37,42 @@ resource "searchstax_user" "test" {
 		},
 	})
 }
+
+func TestAccUserResourceWriteOnlyPassword(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "searchstax_user" "test" {
+  email                   = "user@company.com"
+  role                    = "Admin"
+  new_password_wo         = "Initial-Passw0rd"
+  new_password_wo_version = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("searchstax_user.test", "new_password_wo"),
+					resource.TestCheckResourceAttr("searchstax_user.test", "new_password_wo_version", "1"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "searchstax_user" "test" {
+  email                   = "user@company.com"
+  role                    = "Admin"
+  new_password_wo         = "Rotated-Passw0rd"
+  new_password_wo_version = 2
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("searchstax_user.test", "new_password_wo"),
+					resource.TestCheckResourceAttr("searchstax_user.test", "new_password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
@@ -47,7 +47,7 @@ func (r *webhookResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := r.client.GetWebhooks(plan.AccountName.ValueString())
+	list, err := r.client.GetWebhooks(ctx, plan.AccountName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading SearchStax webhooks", err.Error())
 		return
@@ -70,7 +70,7 @@ func (r *webhookResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := r.client.GetWebhooks(state.AccountName.ValueString())
+	list, err := r.client.GetWebhooks(ctx, state.AccountName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading SearchStax webhooks", err.Error())
 		return
@@ -93,7 +93,7 @@ func (r *webhookResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	list, err := r.client.GetWebhooks(plan.AccountName.ValueString())
+	list, err := r.client.GetWebhooks(ctx, plan.AccountName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading SearchStax webhooks", err.Error())
 		return
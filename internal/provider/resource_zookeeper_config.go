@@ -45,9 +45,9 @@ func (r *zookeeperConfigResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	out, err := r.client.UploadZookeeperConfig(plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.ZookeeperConfig{Name: plan.Name.ValueString()})
+	out, err := r.client.UploadZookeeperConfig(ctx, plan.AccountName.ValueString(), plan.DeploymentUID.ValueString(), searchstaxClient.ZookeeperConfig{Name: plan.Name.ValueString()})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating zookeeper config", err.Error())
+		resp.Diagnostics.Append(apiErrorDiagnostics("Error creating zookeeper config", "Could not create zookeeper config", err, "name")...)
 		return
 	}
 	plan.Name = types.StringValue(out.Name)
@@ -60,7 +60,7 @@ func (r *zookeeperConfigResource) Read(ctx context.Context, req resource.ReadReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	_, err := r.client.GetZookeeperConfig(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Name.ValueString())
+	_, err := r.client.GetZookeeperConfig(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Name.ValueString())
 	if err != nil {
 		resp.State.RemoveResource(ctx)
 		return
@@ -76,7 +76,7 @@ func (r *zookeeperConfigResource) Delete(ctx context.Context, req resource.Delet
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if err := r.client.DeleteZookeeperConfig(state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Name.ValueString()); err != nil {
+	if err := r.client.DeleteZookeeperConfig(ctx, state.AccountName.ValueString(), state.DeploymentUID.ValueString(), state.Name.ValueString()); err != nil {
 		resp.Diagnostics.AddError("Error deleting zookeeper config", err.Error())
 	}
 }
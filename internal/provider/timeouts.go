@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	searchstaxClient "terraform-provider-searchstax/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// timeoutDiagnostic reports whether err is a client wait that ran out of time
+// (or was canceled) and, if so, returns a diagnostic that names the deployment,
+// the last observed status and the timeouts block key (create, update or
+// delete) that bounds the operation.
+func timeoutDiagnostic(err error, timeoutName string) (diag.Diagnostic, bool) {
+	var timeoutErr *searchstaxClient.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		return nil, false
+	}
+	if errors.Is(timeoutErr, context.Canceled) {
+		return diag.NewErrorDiagnostic(
+			"SearchStax Operation Canceled",
+			timeoutErr.Error()+".",
+		), true
+	}
+	return diag.NewErrorDiagnostic(
+		"Timed Out Waiting for SearchStax Deployment",
+		fmt.Sprintf("%s.\n\nIf the operation normally takes longer, increase timeouts.%s on the resource.", timeoutErr.Error(), timeoutName),
+	), true
+}